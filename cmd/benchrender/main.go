@@ -0,0 +1,209 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"image/color"
+	"log"
+	"math"
+	"math/rand"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+
+	"game/internal/config"
+	"game/internal/entity"
+	"game/internal/render"
+	"game/internal/theme"
+)
+
+// 大量の弾の描画にかかる時間を計測するベンチマーク
+// 画面外の ebiten.Image に弾を描き、描画命令の実行が終わるまでの時間を1フレームごとに測る
+//
+//	go run ./cmd/benchrender
+//	go run ./cmd/benchrender -counts 5000,20000 -frames 120 -mode batched
+func main() {
+	countsFlag := flag.String("counts", "5000,10000,15000,20000", "描画する弾の数（カンマ区切り）")
+	frames := flag.Int("frames", 60, "1つの条件で計測するフレーム数")
+	warmup := flag.Int("warmup", 10, "計測前に捨てるフレーム数")
+	mode := flag.String("mode", "both", "描画方法（batched: 頂点バッファでまとめて描く / circle: 弾ごとに DrawCircle / both）")
+	flag.Parse()
+
+	counts, err := parseCounts(*countsFlag)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var modes []string
+	switch *mode {
+	case "both":
+		modes = []string{"circle", "batched"}
+	case "batched", "circle":
+		modes = []string{*mode}
+	default:
+		log.Fatalf("不明な描画方法です: %s", *mode)
+	}
+
+	b := &bench{
+		target: ebiten.NewImage(config.ScreenWidth, config.ScreenHeight),
+		frames: *frames,
+		warmup: *warmup,
+	}
+	for _, count := range counts {
+		for _, m := range modes {
+			b.cases = append(b.cases, &benchCase{mode: m, bullets: newBullets(count)})
+		}
+	}
+
+	ebiten.SetWindowSize(320, 240)
+	ebiten.SetWindowTitle("benchrender")
+	ebiten.SetVsyncEnabled(false)
+	ebiten.SetTPS(ebiten.SyncWithFPS)
+	if err := ebiten.RunGame(b); err != nil && !errors.Is(err, ebiten.Termination) {
+		log.Fatal(err)
+	}
+
+	b.report()
+}
+
+// benchCase は1つの計測条件とその結果
+type benchCase struct {
+	mode    string
+	bullets []*entity.Bullet
+	samples []time.Duration
+}
+
+// bench は計測条件を順番に実行する ebiten.Game
+type bench struct {
+	target  *ebiten.Image
+	cases   []*benchCase
+	current int
+	frame   int
+	frames  int
+	warmup  int
+}
+
+// Update はすべての条件を計測し終えたら終了する
+func (b *bench) Update() error {
+	if b.current >= len(b.cases) {
+		return ebiten.Termination
+	}
+	return nil
+}
+
+// Draw は現在の条件で1フレーム分の弾を描き、かかった時間を記録する
+func (b *bench) Draw(screen *ebiten.Image) {
+	if b.current >= len(b.cases) {
+		return
+	}
+	c := b.cases[b.current]
+
+	start := time.Now()
+	b.target.Fill(color.RGBA{20, 20, 40, 255})
+	switch c.mode {
+	case "batched":
+		render.DrawBullets(b.target, c.bullets)
+	case "circle":
+		for _, bullet := range c.bullets {
+			ebitenutil.DrawCircle(b.target, bullet.X, bullet.Y, bullet.Size, theme.Current().BulletColor(bullet.Kind, bullet.SpeedTier()))
+		}
+	}
+	// ピクセルを読み出して描画命令の実行が終わるのを待つ
+	b.target.At(0, 0)
+	elapsed := time.Since(start)
+
+	if b.frame >= b.warmup {
+		c.samples = append(c.samples, elapsed)
+	}
+	b.frame++
+
+	// 描いた結果をウィンドウに縮小して表示する
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Scale(0.4, 0.4)
+	screen.DrawImage(b.target, op)
+	ebitenutil.DebugPrint(screen, fmt.Sprintf("%s %d bullets", c.mode, len(c.bullets)))
+
+	if b.frame >= b.warmup+b.frames {
+		b.current++
+		b.frame = 0
+	}
+}
+
+// Layout はウィンドウの大きさをそのまま使う
+func (b *bench) Layout(outsideWidth, outsideHeight int) (int, int) {
+	return outsideWidth, outsideHeight
+}
+
+// report は条件ごとの1フレームあたりの描画時間を表にして表示する
+func (b *bench) report() {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "mode\tbullets\tavg ms\tp50 ms\tp95 ms\tmax ms\t")
+	for _, c := range b.cases {
+		if len(c.samples) == 0 {
+			continue
+		}
+		sorted := append([]time.Duration(nil), c.samples...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		var total time.Duration
+		for _, s := range sorted {
+			total += s
+		}
+		fmt.Fprintf(w, "%s\t%d\t%.2f\t%.2f\t%.2f\t%.2f\t\n",
+			c.mode, len(c.bullets),
+			ms(total/time.Duration(len(sorted))),
+			ms(percentile(sorted, 0.5)),
+			ms(percentile(sorted, 0.95)),
+			ms(sorted[len(sorted)-1]))
+	}
+	w.Flush()
+}
+
+// newBullets は画面全体にランダムな種類・向き・速さの弾を count 個作成する
+func newBullets(count int) []*entity.Bullet {
+	bullet := config.Current().Bullet
+	bullets := make([]*entity.Bullet, count)
+	for i := range bullets {
+		angle := rand.Float64() * 2 * math.Pi
+		speed := bullet.SpeedMin + rand.Float64()*(bullet.SpeedMax*1.5-bullet.SpeedMin)
+		bullets[i] = entity.NewKindBullet(
+			entity.BulletKinds[rand.Intn(len(entity.BulletKinds))],
+			rand.Float64()*config.ScreenWidth,
+			rand.Float64()*config.ScreenHeight,
+			math.Cos(angle)*speed,
+			math.Sin(angle)*speed,
+			bullet.Size,
+		)
+	}
+	return bullets
+}
+
+// parseCounts はカンマ区切りの弾の数を読み取る
+func parseCounts(s string) ([]int, error) {
+	var counts []int
+	for _, field := range strings.Split(s, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("弾の数が不正です: %q", field)
+		}
+		counts = append(counts, n)
+	}
+	return counts, nil
+}
+
+// percentile は昇順に並んだ計測値の p の割合の位置の値を返す
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	i := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[max(0, min(i, len(sorted)-1))]
+}
+
+// ms は時間をミリ秒で返す
+func ms(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
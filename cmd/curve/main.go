@@ -0,0 +1,77 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"game/internal/config"
+	"game/internal/difficulty"
+)
+
+// 難易度カーブをレベルごとの表として表示する確認用ツール
+//
+//	go run ./cmd/curve -preset NORMAL -levels 30
+//	go run ./cmd/curve -file my_curves.json
+func main() {
+	file := flag.String("file", "", "難易度カーブのJSONファイル（省略時は組み込みのカーブ）")
+	presetName := flag.String("preset", "", "表示する難易度プリセット名（省略時はすべて）")
+	levels := flag.Int("levels", 0, "表示するレベルの数（省略時は上限レベル+5）")
+	flag.Parse()
+
+	if *file != "" {
+		set, err := difficulty.LoadFile(*file)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := difficulty.Use(set); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	found := false
+	for i, preset := range config.Current().Presets() {
+		if *presetName != "" && !strings.EqualFold(*presetName, preset.Name) {
+			continue
+		}
+		found = true
+		printCurve(config.DifficultyKind(i), *levels)
+	}
+	if !found {
+		log.Fatalf("難易度プリセット %s は存在しません", *presetName)
+	}
+}
+
+// printCurve は1つの難易度プリセットのカーブを表示する
+func printCurve(kind config.DifficultyKind, levels int) {
+	preset := config.Current().Preset(kind)
+	curve := difficulty.For(kind)
+	if levels <= 0 {
+		levels = curve.MaxLevel + 5
+	}
+
+	fmt.Printf("== %s (開始レベル %d, %.1f秒ごとにレベルアップ, 上限レベル %d)\n", preset.Name, preset.StartLevel, preset.LevelUpInterval, curve.MaxLevel)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "level\ttime(s)\tbullets/wave\tspeed x\tinterval(s)\tbullets/s\tnote\tpatterns\t")
+	for level := preset.StartLevel; level < preset.StartLevel+levels; level++ {
+		p := curve.At(level)
+		reachedAt := float64(level-preset.StartLevel) * preset.LevelUpInterval
+
+		note := ""
+		if p.Breather {
+			note = "break"
+		} else if curve.MaxLevel > 0 && level > curve.MaxLevel {
+			note = "cap"
+		}
+
+		fmt.Fprintf(w, "%d\t%.0f\t%d\t%.2f\t%.3f\t%.1f\t%s\t%s\t\n",
+			level, reachedAt, p.BulletsPerWave, p.SpeedMultiplier, p.SpawnInterval,
+			float64(p.BulletsPerWave)/p.SpawnInterval, note, strings.Join(p.Patterns, ","))
+	}
+	w.Flush()
+	fmt.Println()
+}
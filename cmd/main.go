@@ -1,30 +1,55 @@
 package main
 
 import (
+	"flag"
 	"log"
-	"math/rand"
+	"log/slog"
 	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
 
 	"game/internal/config"
+	"game/internal/daily"
+	"game/internal/debug"
+	"game/internal/difficulty"
 	"game/internal/game"
+	"game/internal/hud"
+	"game/internal/i18n"
+	"game/internal/logging"
 	"game/internal/render"
+	"game/internal/theme"
 )
 
 // Game はEbitenのゲームインターフェースを実装する
 type Game struct {
-	gameState *game.Game
+	gameState     *game.Game
+	configWatcher *config.Watcher // 設定ファイルの監視（ホットリロード無効時はnil）
+	debugTools    *debug.Tools    // デバッグ表示とコンソール（リリースビルドでは何もしない）
 }
 
 // Update はゲームの状態を更新する
 func (g *Game) Update() error {
+	// 設定ファイルが更新されていれば実行中のゲームに反映する
+	if g.configWatcher != nil {
+		select {
+		case c := <-g.configWatcher.Updates():
+			g.gameState.ApplyConfig(c)
+		default:
+		}
+	}
+
+	// デバッグコンソールが開いている間はキー入力をゲームに渡さない
+	if g.debugTools.Update() {
+		return nil
+	}
+
 	return g.gameState.Update()
 }
 
 // Draw はゲームの状態を描画する
 func (g *Game) Draw(screen *ebiten.Image) {
 	render.Draw(screen, g.gameState)
+	g.debugTools.Draw(screen)
 }
 
 // Layout はウィンドウサイズを返す
@@ -33,14 +58,121 @@ func (g *Game) Layout(outsideWidth, outsideHeight int) (int, int) {
 }
 
 func main() {
-	// 乱数の初期化
-	rand.Seed(time.Now().UnixNano())
-	
+	configPath := flag.String("config", "", "ゲーム設定のJSONファイル（省略時は既定の設定）")
+	watch := flag.Bool("watch", false, "設定ファイルの変更を監視して実行中のゲームに反映する（開発用）")
+	curvesPath := flag.String("curves", "", "難易度カーブのJSONファイル（省略時は組み込みのカーブ）")
+	hudPath := flag.String("hud", "", "HUDレイアウトのJSONファイル（省略時は組み込みのレイアウト）")
+	lang := flag.String("lang", "", "表示言語（ja / en、省略時は環境変数から推測）")
+	themeID := flag.String("theme", "", "配色（classic / high_contrast / deuteranopia / protanopia / tritanopia / monochrome）")
+	verbose := flag.Bool("verbose", false, "詳細なログ（弾の消去やグレイズなど頻繁な出来事を含む）を出力する（-log-level を指定した場合はそちらが優先）")
+	logLevel := flag.String("log-level", "", "ログの重要度（debug / info / warn / error、省略時は開発用ビルドでinfo、リリースビルドでwarn）")
+	logFormat := flag.String("log-format", "text", "ログの形式（text / json）")
+	logFile := flag.String("log-file", "", "ログの出力先のファイル（省略時は標準エラー出力）")
+	dailyPath := flag.String("daily-file", "", "デイリーチャレンジの記録のファイル（省略時はユーザーの設定ディレクトリの下）")
+	flag.Parse()
+
+	// ログの設定
+	logOpts := logging.DefaultOptions()
+	logOpts.Format = *logFormat
+	logOpts.File = *logFile
+	switch {
+	case *logLevel != "":
+		// 重要度を明示した場合は -verbose より優先する
+		level, err := logging.ParseLevel(*logLevel)
+		if err != nil {
+			log.Fatal(err)
+		}
+		logOpts.Level = level
+	case *verbose:
+		logOpts.Level = slog.LevelDebug
+	}
+	logCloser, err := logging.Setup(logOpts)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer logCloser.Close()
+
+	// ゲーム設定の読み込み
+	if *configPath != "" {
+		c, err := config.Load(*configPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		config.Set(c)
+	}
+
+	// 難易度カーブの読み込み
+	if *curvesPath != "" {
+		set, err := difficulty.LoadFile(*curvesPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := difficulty.Use(set); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// HUDレイアウトの読み込み
+	if *hudPath != "" {
+		l, err := hud.LoadFile(*hudPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		hud.Use(l)
+	}
+
+	// 表示言語の設定
+	locale := i18n.Detect()
+	if *lang != "" {
+		locale = i18n.Locale(*lang)
+	}
+	if err := i18n.Set(locale); err != nil {
+		log.Fatal(err)
+	}
+
+	// 配色の設定
+	if *themeID != "" {
+		if err := theme.Set(*themeID); err != nil {
+			log.Fatal(err)
+		}
+	}
+
 	ebiten.SetWindowSize(config.ScreenWidth, config.ScreenHeight)
-	ebiten.SetWindowTitle("弾幕避けゲーム")
+	ebiten.SetWindowTitle(i18n.T("window.title"))
+	ebiten.SetWindowResizingMode(ebiten.WindowResizingModeEnabled)
+	
+	gameState := game.NewGame(game.NormalRun(config.DefaultDifficulty))
 	
+	// デイリーチャレンジの記録の読み込み（読み込めなくてもゲームは続け、記録は保存しない）
+	var pathErr error
+	if *dailyPath == "" {
+		path, err := daily.DefaultPath()
+		if err != nil {
+			slog.Warn("デイリーチャレンジの記録を保存しません", "err", err)
+			pathErr = err
+		}
+		*dailyPath = path
+	}
+	history, err := daily.Open(*dailyPath)
+	if err != nil {
+		slog.Warn("デイリーチャレンジの記録を読み込めないため、このセッションの記録は保存しません", "err", err)
+	}
+	if *dailyPath == "" && pathErr != nil {
+		history.Err = pathErr
+	}
+	gameState.Daily = history
 	g := &Game{
-		gameState: game.NewGame(),
+		gameState:  gameState,
+		debugTools: debug.New(gameState),
+	}
+	
+	// 開発中は設定ファイルの変更を監視する
+	if *watch {
+		if *configPath == "" {
+			log.Fatal("-watch には -config で設定ファイルを指定してください")
+		}
+		g.configWatcher = config.NewWatcher(*configPath, 500*time.Millisecond)
+		defer g.configWatcher.Close()
 	}
 	
 	if err := ebiten.RunGame(g); err != nil {
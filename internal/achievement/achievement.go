@@ -0,0 +1,89 @@
+package achievement
+
+import (
+	"log/slog"
+
+	"game/internal/event"
+	"game/internal/stats"
+)
+
+// Achievement は実績の条件
+type Achievement struct {
+	ID       string                   // メッセージのキーに使う名前
+	Unlocked func(s *stats.Stats) bool // 統計が条件を満たしているかどうか
+}
+
+// achievements は実績の一覧（登録した順に判定する）
+var achievements []Achievement
+
+// register は実績を一覧に追加する
+func register(a Achievement) {
+	achievements = append(achievements, a)
+}
+
+func init() {
+	register(Achievement{ID: "first_bomb", Unlocked: func(s *stats.Stats) bool { return s.Total.BombsUsed >= 1 }})
+	register(Achievement{ID: "graze_100", Unlocked: func(s *stats.Stats) bool { return s.Run.Grazes >= 100 }})
+	register(Achievement{ID: "clear_500", Unlocked: func(s *stats.Stats) bool { return s.Run.BulletsCleared >= 500 }})
+	register(Achievement{ID: "shield_3", Unlocked: func(s *stats.Stats) bool { return s.Run.ShieldHits >= 3 }})
+	register(Achievement{ID: "level_20", Unlocked: func(s *stats.Stats) bool { return s.Run.MaxLevel >= 20 }})
+	register(Achievement{ID: "survive_60", Unlocked: func(s *stats.Stats) bool { return s.Run.BestTime >= 60 }})
+}
+
+// Tracker は統計から実績の解除を判定する
+type Tracker struct {
+	stats    *stats.Stats
+	unlocked map[string]bool
+	recent   []string // 現在のプレイで解除した実績
+	eligible bool     // 現在のプレイで実績を解除できるかどうか
+}
+
+// NewTracker は統計 s を見て実績を判定する Tracker を作成する
+func NewTracker(s *stats.Stats) *Tracker {
+	return &Tracker{stats: s, unlocked: make(map[string]bool), eligible: true}
+}
+
+// BeginRun は現在のプレイで解除した実績の一覧を空にする
+// eligible が false のプレイ（練習モードなど）では実績を解除しない
+func (t *Tracker) BeginRun(eligible bool) {
+	t.recent = nil
+	t.eligible = eligible
+}
+
+// Exclude は現在のプレイをこれ以降、実績の対象外にする（デバッグ用の操作でプレイの内容を変えた場合など）
+func (t *Tracker) Exclude() {
+	t.eligible = false
+}
+
+// Subscribe は実績を判定する購読者を Bus に登録する
+// すべての出来事の購読者として登録するため、統計の更新より後に判定される
+func (t *Tracker) Subscribe(bus *event.Bus) {
+	bus.SubscribeAll(func(event.Event) {
+		t.check()
+	})
+}
+
+// check はまだ解除していない実績の条件を調べる
+func (t *Tracker) check() {
+	if !t.eligible {
+		return
+	}
+	for _, a := range achievements {
+		if t.unlocked[a.ID] || !a.Unlocked(t.stats) {
+			continue
+		}
+		t.unlocked[a.ID] = true
+		t.recent = append(t.recent, a.ID)
+		slog.Info("実績解除", "achievement", a.ID)
+	}
+}
+
+// Recent は現在のプレイで解除した実績の名前を解除した順に返す
+func (t *Tracker) Recent() []string {
+	return t.recent
+}
+
+// IsUnlocked は実績を解除済みかどうかを返す
+func (t *Tracker) IsUnlocked(id string) bool {
+	return t.unlocked[id]
+}
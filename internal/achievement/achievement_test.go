@@ -0,0 +1,72 @@
+package achievement
+
+import (
+	"reflect"
+	"testing"
+
+	"game/internal/event"
+	"game/internal/stats"
+)
+
+// newTracker は同じ Bus を購読する統計と Tracker を作成する
+func newTracker() (*Tracker, *stats.Stats, *event.Bus) {
+	s := stats.New()
+	tr := NewTracker(s)
+	bus := event.NewBus()
+	s.Subscribe(bus)
+	tr.Subscribe(bus)
+	return tr, s, bus
+}
+
+func TestUnlock(t *testing.T) {
+	tr, s, bus := newTracker()
+	s.BeginRun(true)
+	tr.BeginRun(true)
+
+	bus.Emit(event.BombUsed{})
+	for i := 0; i < 3; i++ {
+		bus.Emit(event.ShieldHit{})
+	}
+	bus.Emit(event.BombUsed{}) // 解除済みの実績は2回解除しない
+
+	if want := []string{"first_bomb", "shield_3"}; !reflect.DeepEqual(tr.Recent(), want) {
+		t.Errorf("Recent() = %v, want %v", tr.Recent(), want)
+	}
+	if !tr.IsUnlocked("first_bomb") || tr.IsUnlocked("graze_100") {
+		t.Error("IsUnlocked が解除した実績と一致しません")
+	}
+
+	// 次のプレイでは Recent だけが空になる
+	s.BeginRun(true)
+	tr.BeginRun(true)
+	if len(tr.Recent()) != 0 || !tr.IsUnlocked("first_bomb") {
+		t.Errorf("Recent() = %v", tr.Recent())
+	}
+}
+
+func TestIneligibleRuns(t *testing.T) {
+	tests := []struct {
+		name  string
+		begin func(tr *Tracker, s *stats.Stats)
+	}{
+		{"対象外のプレイ", func(tr *Tracker, s *stats.Stats) { s.BeginRun(false); tr.BeginRun(false) }},
+		{"途中で対象外になったプレイ", func(tr *Tracker, s *stats.Stats) {
+			s.BeginRun(true)
+			tr.BeginRun(true)
+			s.Exclude()
+			tr.Exclude()
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr, s, bus := newTracker()
+			tt.begin(tr, s)
+			for i := 0; i < 3; i++ {
+				bus.Emit(event.ShieldHit{})
+			}
+			if len(tr.Recent()) != 0 || tr.IsUnlocked("shield_3") {
+				t.Errorf("対象外のプレイで実績を解除しました: %v", tr.Recent())
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+package audio
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io"
+	"path"
+
+	eaudio "github.com/hajimehoshi/ebiten/v2/audio"
+	"github.com/hajimehoshi/ebiten/v2/audio/vorbis"
+	"github.com/hajimehoshi/ebiten/v2/audio/wav"
+
+	"game/internal/config"
+)
+
+// SampleRate は再生するサンプリング周波数（素材は読み込み時にこの周波数へ変換する）
+const SampleRate = 44100
+
+//go:embed assets/*
+var assets embed.FS
+
+// Sound は効果音の種類
+type Sound int
+
+// 効果音の種類
+const (
+	SoundBomb Sound = iota
+	SoundShieldPickup
+	SoundShieldHit
+	SoundGraze
+	SoundLevelUp
+	SoundDeath
+)
+
+// Track はBGMの曲
+type Track int
+
+// BGMの曲
+const (
+	TrackNone  Track = iota // 無音
+	TrackTitle              // タイトル画面
+	TrackGame               // プレイ中
+)
+
+// Channel は音量を個別に設定できる系統
+type Channel int
+
+// 音量の系統
+const (
+	ChannelMaster Channel = iota // 全体（他の系統に掛け合わせる）
+	ChannelMusic                 // BGM
+	ChannelSFX                   // 効果音
+)
+
+// ChannelCount は音量の系統の数
+const ChannelCount = 3
+
+// soundSpec は効果音の素材と鳴らし方
+type soundSpec struct {
+	file        string
+	volume      float64 // 素材ごとの音量の補正
+	maxVoices   int     // 同時に鳴らせる数（超えたら最も古い音を止める）
+	minInterval float64 // 続けて鳴らすときの最短の間隔（秒）
+}
+
+// soundSpecs は効果音ごとの素材と鳴らし方
+// グレイズは1秒に何百回も起こりうるため、同時発音数と間隔を絞って音割れを防ぐ
+var soundSpecs = map[Sound]soundSpec{
+	SoundBomb:         {file: "bomb.wav", volume: 0.9, maxVoices: 2, minInterval: 0.1},
+	SoundShieldPickup: {file: "shield_pickup.wav", volume: 0.7, maxVoices: 2, minInterval: 0.05},
+	SoundShieldHit:    {file: "shield_hit.wav", volume: 0.8, maxVoices: 2, minInterval: 0.05},
+	SoundGraze:        {file: "graze.wav", volume: 0.35, maxVoices: 3, minInterval: 0.04},
+	SoundLevelUp:      {file: "levelup.wav", volume: 0.6, maxVoices: 1, minInterval: 0.2},
+	SoundDeath:        {file: "death.wav", volume: 1.0, maxVoices: 1, minInterval: 0.5},
+}
+
+// trackFiles はBGMの曲ごとの素材（ループ再生する）
+var trackFiles = map[Track]string{
+	TrackTitle: "bgm_title.wav",
+	TrackGame:  "bgm_game.wav",
+}
+
+// voice は鳴っている1つの効果音
+type voice struct {
+	sound  Sound
+	player *eaudio.Player
+	gain   float64 // 素材ごとの音量の補正
+}
+
+// music はループ再生中のBGM
+type music struct {
+	track  Track
+	player *eaudio.Player
+	fade   float64 // クロスフェードの進み具合（0で無音、1で最大）
+}
+
+// Mixer は効果音とBGMの再生を管理する
+// nil の Mixer では何も鳴らさない（音声を初期化できなかった場合でもゲームは続けられる）
+type Mixer struct {
+	context  *eaudio.Context
+	sounds   map[Sound][]byte // 効果音ごとの変換済みの波形
+	tracks   map[Track][]byte // BGMごとの変換済みの波形
+	volumes  [ChannelCount]float64
+	voices   []*voice
+	lastPlay map[Sound]float64 // 効果音ごとの最後に鳴らした時刻
+	clock    float64           // Update で進める経過時間（秒）
+	current  *music            // 鳴らしている曲（フェードイン中を含む）
+	fading   *music            // フェードアウト中の前の曲
+}
+
+// New は組み込みの素材を読み込んで Mixer を作成する
+func New() (*Mixer, error) {
+	m := &Mixer{
+		context:  eaudio.NewContext(SampleRate),
+		sounds:   make(map[Sound][]byte, len(soundSpecs)),
+		tracks:   make(map[Track][]byte, len(trackFiles)),
+		lastPlay: make(map[Sound]float64, len(soundSpecs)),
+		volumes:  [ChannelCount]float64{1, config.DefaultMusicVolume, config.DefaultSFXVolume},
+	}
+	for s, spec := range soundSpecs {
+		pcm, err := decode(spec.file)
+		if err != nil {
+			return nil, err
+		}
+		m.sounds[s] = pcm
+	}
+	for t, file := range trackFiles {
+		pcm, err := decode(file)
+		if err != nil {
+			return nil, err
+		}
+		m.tracks[t] = pcm
+	}
+	return m, nil
+}
+
+// decode は組み込みの素材（WAV か OGG）を再生用の波形に変換する
+func decode(file string) ([]byte, error) {
+	data, err := assets.ReadFile("assets/" + file)
+	if err != nil {
+		return nil, fmt.Errorf("音声素材 %s を読み込めません: %w", file, err)
+	}
+
+	var stream io.Reader
+	switch path.Ext(file) {
+	case ".wav":
+		stream, err = wav.DecodeWithSampleRate(SampleRate, bytes.NewReader(data))
+	case ".ogg":
+		stream, err = vorbis.DecodeWithSampleRate(SampleRate, bytes.NewReader(data))
+	default:
+		return nil, fmt.Errorf("音声素材 %s の形式に対応していません", file)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("音声素材 %s を変換できません: %w", file, err)
+	}
+
+	pcm, err := io.ReadAll(stream)
+	if err != nil {
+		return nil, fmt.Errorf("音声素材 %s を変換できません: %w", file, err)
+	}
+	return pcm, nil
+}
+
+// Play は効果音を鳴らす
+// 前回から最短の間隔が経っていなければ鳴らさず、同時発音数を超える場合は最も古い音を止める
+func (m *Mixer) Play(s Sound) {
+	if m == nil {
+		return
+	}
+	spec := soundSpecs[s]
+	if last, ok := m.lastPlay[s]; ok && m.clock-last < spec.minInterval {
+		return
+	}
+	m.lastPlay[s] = m.clock
+
+	// 同じ効果音の数と、効果音全体の数を上限に収める
+	if m.count(s) >= spec.maxVoices {
+		m.stopOldest(func(v *voice) bool { return v.sound == s })
+	}
+	if len(m.voices) >= config.MaxSFXVoices {
+		m.stopOldest(func(v *voice) bool { return true })
+	}
+
+	v := &voice{sound: s, player: m.context.NewPlayerFromBytes(m.sounds[s]), gain: spec.volume}
+	v.player.SetVolume(v.gain * m.Volume(ChannelSFX) * m.Volume(ChannelMaster))
+	v.player.Play()
+	m.voices = append(m.voices, v)
+}
+
+// count は鳴っている効果音 s の数を返す
+func (m *Mixer) count(s Sound) int {
+	n := 0
+	for _, v := range m.voices {
+		if v.sound == s {
+			n++
+		}
+	}
+	return n
+}
+
+// stopOldest は条件に合う鳴っている効果音のうち、最も古いものを止める
+func (m *Mixer) stopOldest(match func(v *voice) bool) {
+	for i, v := range m.voices {
+		if match(v) {
+			v.player.Close()
+			m.voices = append(m.voices[:i], m.voices[i+1:]...)
+			return
+		}
+	}
+}
+
+// PlayMusic は曲を切り替える（今の曲はフェードアウトし、新しい曲はフェードインする）
+// 同じ曲を指定した場合は何もしない
+func (m *Mixer) PlayMusic(t Track) {
+	if m == nil || (m.current != nil && m.current.track == t) || (m.current == nil && t == TrackNone) {
+		return
+	}
+
+	if m.fading != nil {
+		m.fading.player.Close()
+	}
+	m.fading = m.current
+	m.current = nil
+	if t == TrackNone {
+		return
+	}
+
+	pcm := m.tracks[t]
+	loop := eaudio.NewInfiniteLoop(bytes.NewReader(pcm), int64(len(pcm)))
+	player, err := m.context.NewPlayer(loop)
+	if err != nil {
+		return
+	}
+	m.current = &music{track: t, player: player}
+	m.applyMusicVolume(m.current)
+	player.Play()
+}
+
+// Update は時間を dt 秒進め、BGMのクロスフェードと鳴り終わった効果音の片付けを行う
+func (m *Mixer) Update(dt float64) {
+	if m == nil {
+		return
+	}
+	m.clock += dt
+
+	// 鳴り終わった効果音を片付ける
+	voices := m.voices[:0]
+	for _, v := range m.voices {
+		if v.player.IsPlaying() {
+			voices = append(voices, v)
+		} else {
+			v.player.Close()
+		}
+	}
+	m.voices = voices
+
+	// クロスフェード
+	step := dt / config.MusicCrossfade
+	if m.current != nil && m.current.fade < 1 {
+		m.current.fade = min(1, m.current.fade+step)
+		m.applyMusicVolume(m.current)
+	}
+	if m.fading != nil {
+		m.fading.fade -= step
+		if m.fading.fade <= 0 {
+			m.fading.player.Close()
+			m.fading = nil
+		} else {
+			m.applyMusicVolume(m.fading)
+		}
+	}
+}
+
+// Volume は系統の音量（0〜1）を返す
+func (m *Mixer) Volume(ch Channel) float64 {
+	if m == nil {
+		return 0
+	}
+	return m.volumes[ch]
+}
+
+// SetVolume は系統の音量（0〜1）を設定し、鳴っている音にも反映する
+func (m *Mixer) SetVolume(ch Channel, volume float64) {
+	if m == nil {
+		return
+	}
+	m.volumes[ch] = max(0, min(1, volume))
+
+	for _, v := range m.voices {
+		v.player.SetVolume(v.gain * m.Volume(ChannelSFX) * m.Volume(ChannelMaster))
+	}
+	for _, mu := range []*music{m.current, m.fading} {
+		if mu != nil {
+			m.applyMusicVolume(mu)
+		}
+	}
+}
+
+// applyMusicVolume はクロスフェードの進み具合と音量の設定をBGMに反映する
+func (m *Mixer) applyMusicVolume(mu *music) {
+	mu.player.SetVolume(mu.fade * m.Volume(ChannelMusic) * m.Volume(ChannelMaster))
+}
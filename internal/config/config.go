@@ -0,0 +1,417 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Config は実行時に読み込めるゲームバランスの設定
+// 既定値は constants.go の定数と同じで、設定ファイルには変更したい項目だけを書けばよい
+type Config struct {
+	Player     PlayerConfig     `json:"player"`
+	Bullet     BulletConfig     `json:"bullet"`
+	Shield     ShieldConfig     `json:"shield"`
+	ScoreItem  ScoreItemConfig  `json:"score_item"`
+	Items      ItemsConfig      `json:"items"`
+	SlowMotion SlowMotionConfig `json:"slow_motion"`
+	Graze      GrazeConfig      `json:"graze"`
+	Bombs      BombsConfig      `json:"bombs"`
+	Difficulty DifficultyConfig `json:"difficulty"`
+	Spawn      SpawnConfig      `json:"spawn"`
+	DDA        DDAConfig        `json:"dda"`
+}
+
+// PlayerConfig はプレイヤーの設定
+type PlayerConfig struct {
+	Size float64 `json:"size"`
+}
+
+// BulletConfig は弾の設定
+type BulletConfig struct {
+	Size     float64 `json:"size"`
+	SpeedMin float64 `json:"speed_min"`
+	SpeedMax float64 `json:"speed_max"`
+	Initial  int     `json:"initial"` // 開始時に画面にある弾の数
+}
+
+// ShieldConfig はシールドの設定
+type ShieldConfig struct {
+	Durability int     `json:"durability"` // シールドの耐久値
+	ItemSize   float64 `json:"item_size"`  // シールドアイテムのサイズ
+}
+
+// ScoreItemConfig はボムで消した弾から生まれる得点アイテムの設定
+type ScoreItemConfig struct {
+	Size     float64 `json:"size"`
+	Value    int     `json:"value"`
+	SpeedMax float64 `json:"speed_max"`
+}
+
+// ItemSpec はアイテムの種類ごとの設定
+type ItemSpec struct {
+	Weight   float64 `json:"weight"`   // 出現の重み（大きいほど出やすい）
+	Lifetime float64 `json:"lifetime"` // 画面上に留まる時間（秒）
+	Duration float64 `json:"duration"` // 取得後の効果時間（秒、即時効果のアイテムは0）
+}
+
+// ItemsConfig はパワーアップアイテムの設定
+type ItemsConfig struct {
+	Size            float64 `json:"size"`         // シールド以外のアイテムのサイズ
+	SpawnJitter     float64 `json:"spawn_jitter"` // 出現間隔のランダムな揺らぎ（秒）
+	Max             int     `json:"max"`          // 同時に画面上に存在できるアイテムの数
+	BlinkTime       float64 `json:"blink_time"`   // 消滅前に点滅を始める残り時間（秒）
+	ScoreGemValue   int     `json:"score_gem_value"`
+	MagnetRadius    float64 `json:"magnet_radius"`
+	MagnetPullSpeed float64 `json:"magnet_pull_speed"`
+	ShrinkScale     float64 `json:"shrink_scale"`
+
+	Shield     ItemSpec `json:"shield"`
+	BombRefill ItemSpec `json:"bomb_refill"`
+	SlowMotion ItemSpec `json:"slow_motion"`
+	Magnet     ItemSpec `json:"magnet"`
+	ScoreGem   ItemSpec `json:"score_gem"`
+	Shrink     ItemSpec `json:"shrink"`
+	Invincible ItemSpec `json:"invincible"`
+}
+
+// SlowMotionConfig はスローモーションとスロースキルの設定
+type SlowMotionConfig struct {
+	Factor        float64 `json:"factor"`         // 弾の速度・発生頻度の倍率
+	SkillDuration float64 `json:"skill_duration"` // スロースキルの効果時間（秒）
+	SkillCooldown float64 `json:"skill_cooldown"` // スロースキルのクールダウン（秒）
+	Saturation    float64 `json:"saturation"`     // 画面の彩度
+}
+
+// GrazeConfig はグレイズの設定
+type GrazeConfig struct {
+	Margin float64 `json:"margin"` // 当たり判定の外側でグレイズとみなす距離
+}
+
+// BombVariant はボムの種類ごとの設定
+type BombVariant struct {
+	Name     string  `json:"-"`        // 表示名
+	Radius   float64 `json:"radius"`   // 爆発の最大半径（円形のみ）
+	Duration float64 `json:"duration"` // 効果の持続時間（秒）
+	Cooldown float64 `json:"cooldown"` // クールダウン時間（秒）
+}
+
+// BombsConfig はボムの種類ごとの設定
+type BombsConfig struct {
+	Radial    BombVariant `json:"radial"`
+	LineSweep BombVariant `json:"line_sweep"`
+	TimeStop  BombVariant `json:"time_stop"`
+}
+
+// DifficultyPreset は難易度プリセットごとの設定
+// 発射数や弾速などレベルに対する変化は internal/difficulty の難易度カーブで定義する
+type DifficultyPreset struct {
+	Name              string  `json:"-"`
+	StartLevel        int     `json:"start_level"`         // 開始時の難易度レベル
+	LevelUpInterval   float64 `json:"level_up_interval"`   // 難易度レベルが上がる間隔（秒）
+	ItemSpawnInterval float64 `json:"item_spawn_interval"` // アイテム出現の平均間隔（秒）
+	ShieldWeightScale float64 `json:"shield_weight_scale"` // シールドアイテムの出現の重みの倍率
+	BombCooldownScale float64 `json:"bomb_cooldown_scale"` // ボムのクールダウンの倍率
+}
+
+// DifficultyConfig は難易度プリセットごとの設定
+type DifficultyConfig struct {
+	Easy    DifficultyPreset `json:"easy"`
+	Normal  DifficultyPreset `json:"normal"`
+	Hard    DifficultyPreset `json:"hard"`
+	Lunatic DifficultyPreset `json:"lunatic"`
+}
+
+// SpawnConfig はアイテムなどの出現位置の選び方の設定
+type SpawnConfig struct {
+	Candidates        int     `json:"candidates"`          // 1回の配置で評価する候補位置の数
+	MinBulletDistance float64 `json:"min_bullet_distance"` // 弾（予測位置を含む）からの最低距離
+	MinPlayerDistance float64 `json:"min_player_distance"` // プレイヤーからの最低距離
+	PredictSeconds    float64 `json:"predict_seconds"`     // 弾の動きを予測する時間（秒）
+	PredictStep       int     `json:"predict_step"`        // 予測で位置を調べるフレーム間隔
+	DensityRadius     float64 `json:"density_radius"`      // 弾の密度を数える範囲
+	RetryDelay        float64 `json:"retry_delay"`         // 安全な位置が見つからなかった場合の再試行までの時間（秒）
+}
+
+// DDAConfig はアシストモードの動的難易度調整の設定
+type DDAConfig struct {
+	EvalInterval    float64 `json:"eval_interval"`     // 負荷を評価する間隔（秒）
+	Step            float64 `json:"step"`              // 1回の評価で難易度係数を動かす量
+	MinFactor       float64 `json:"min_factor"`        // 難易度係数の下限
+	MaxFactor       float64 `json:"max_factor"`        // 難易度係数の上限
+	NearMissWeight  float64 `json:"near_miss_weight"`  // ニアミス1回あたりの負荷
+	ShieldHitWeight float64 `json:"shield_hit_weight"` // シールド被弾1回あたりの負荷
+	BombWeight      float64 `json:"bomb_weight"`       // ボム使用1回あたりの負荷
+	StressHigh      float64 `json:"stress_high"`       // これより負荷が高ければ緩める
+	StressLow       float64 `json:"stress_low"`        // これより負荷が低ければ強める
+	TargetSurvival  float64 `json:"target_survival"`   // 目標とする生存時間（秒）
+	RecentRuns      int     `json:"recent_runs"`       // 開始時の係数を決めるのに使う直近のプレイ数
+}
+
+// Default は定数と同じ値の既定の設定を返す
+func Default() *Config {
+	return &Config{
+		Player: PlayerConfig{Size: PlayerSize},
+		Bullet: BulletConfig{
+			Size:     BulletSize,
+			SpeedMin: BulletSpeedMin,
+			SpeedMax: BulletSpeedMax,
+			Initial:  InitialBullets,
+		},
+		Shield: ShieldConfig{Durability: ShieldDurability, ItemSize: ShieldItemSize},
+		ScoreItem: ScoreItemConfig{
+			Size:     ScoreItemSize,
+			Value:    ScoreItemValue,
+			SpeedMax: ScoreItemSpeedMax,
+		},
+		Items: ItemsConfig{
+			Size:            ItemSize,
+			SpawnJitter:     ItemSpawnJitter,
+			Max:             MaxItems,
+			BlinkTime:       ItemBlinkTime,
+			ScoreGemValue:   ScoreGemValue,
+			MagnetRadius:    MagnetRadius,
+			MagnetPullSpeed: MagnetPullSpeed,
+			ShrinkScale:     ShrinkScale,
+
+			Shield:     ItemSpec{Weight: ShieldItemWeight, Lifetime: ShieldItemLifetime},
+			BombRefill: ItemSpec{Weight: BombRefillItemWeight, Lifetime: BombRefillItemLifetime},
+			SlowMotion: ItemSpec{Weight: SlowMotionItemWeight, Lifetime: SlowMotionItemLifetime, Duration: SlowMotionItemDuration},
+			Magnet:     ItemSpec{Weight: MagnetItemWeight, Lifetime: MagnetItemLifetime, Duration: MagnetItemDuration},
+			ScoreGem:   ItemSpec{Weight: ScoreGemItemWeight, Lifetime: ScoreGemItemLifetime},
+			Shrink:     ItemSpec{Weight: ShrinkItemWeight, Lifetime: ShrinkItemLifetime, Duration: ShrinkItemDuration},
+			Invincible: ItemSpec{Weight: InvincibleItemWeight, Lifetime: InvincibleItemLifetime, Duration: InvincibleItemDuration},
+		},
+		SlowMotion: SlowMotionConfig{
+			Factor:        SlowMotionFactor,
+			SkillDuration: SlowSkillDuration,
+			SkillCooldown: SlowSkillCooldown,
+			Saturation:    SlowMotionSaturation,
+		},
+		Graze: GrazeConfig{Margin: GrazeMargin},
+		Bombs: BombsConfig{
+			Radial:    BombVariant{Name: "RADIAL", Radius: RadialBombRadius, Duration: RadialBombDuration, Cooldown: RadialBombCooldown},
+			LineSweep: BombVariant{Name: "SWEEP", Duration: LineSweepDuration, Cooldown: LineSweepCooldown},
+			TimeStop:  BombVariant{Name: "TIME STOP", Duration: TimeStopDuration, Cooldown: TimeStopCooldown},
+		},
+		Difficulty: DifficultyConfig{
+			Easy: DifficultyPreset{
+				Name: "EASY", StartLevel: EasyStartLevel, LevelUpInterval: EasyLevelUpInterval,
+				ItemSpawnInterval: EasyItemSpawnInterval, ShieldWeightScale: EasyShieldWeightScale, BombCooldownScale: EasyBombCooldownScale,
+			},
+			Normal: DifficultyPreset{
+				Name: "NORMAL", StartLevel: NormalStartLevel, LevelUpInterval: NormalLevelUpInterval,
+				ItemSpawnInterval: NormalItemSpawnInterval, ShieldWeightScale: NormalShieldWeightScale, BombCooldownScale: NormalBombCooldownScale,
+			},
+			Hard: DifficultyPreset{
+				Name: "HARD", StartLevel: HardStartLevel, LevelUpInterval: HardLevelUpInterval,
+				ItemSpawnInterval: HardItemSpawnInterval, ShieldWeightScale: HardShieldWeightScale, BombCooldownScale: HardBombCooldownScale,
+			},
+			Lunatic: DifficultyPreset{
+				Name: "LUNATIC", StartLevel: LunaticStartLevel, LevelUpInterval: LunaticLevelUpInterval,
+				ItemSpawnInterval: LunaticItemSpawnInterval, ShieldWeightScale: LunaticShieldWeightScale, BombCooldownScale: LunaticBombCooldownScale,
+			},
+		},
+		Spawn: SpawnConfig{
+			Candidates:        SpawnCandidates,
+			MinBulletDistance: SpawnMinBulletDistance,
+			MinPlayerDistance: SpawnMinPlayerDistance,
+			PredictSeconds:    SpawnPredictSeconds,
+			PredictStep:       SpawnPredictStep,
+			DensityRadius:     SpawnDensityRadius,
+			RetryDelay:        SpawnRetryDelay,
+		},
+		DDA: DDAConfig{
+			EvalInterval:    DDAEvalInterval,
+			Step:            DDAStep,
+			MinFactor:       DDAMinFactor,
+			MaxFactor:       DDAMaxFactor,
+			NearMissWeight:  DDANearMissWeight,
+			ShieldHitWeight: DDAShieldHitWeight,
+			BombWeight:      DDABombWeight,
+			StressHigh:      DDAStressHigh,
+			StressLow:       DDAStressLow,
+			TargetSurvival:  DDATargetSurvival,
+			RecentRuns:      DDARecentRuns,
+		},
+	}
+}
+
+// Bomb は指定した種類のボムの設定を返す
+func (c *Config) Bomb(kind BombKind) BombVariant {
+	switch kind {
+	case BombLineSweep:
+		return c.Bombs.LineSweep
+	case BombTimeStop:
+		return c.Bombs.TimeStop
+	default:
+		return c.Bombs.Radial
+	}
+}
+
+// Preset は指定した難易度プリセットの設定を返す
+func (c *Config) Preset(kind DifficultyKind) DifficultyPreset {
+	switch kind {
+	case DifficultyEasy:
+		return c.Difficulty.Easy
+	case DifficultyHard:
+		return c.Difficulty.Hard
+	case DifficultyLunatic:
+		return c.Difficulty.Lunatic
+	default:
+		return c.Difficulty.Normal
+	}
+}
+
+// Presets は難易度プリセットの設定をDifficultyKindの順に返す
+func (c *Config) Presets() []DifficultyPreset {
+	presets := make([]DifficultyPreset, DifficultyCount)
+	for i := range presets {
+		presets[i] = c.Preset(DifficultyKind(i))
+	}
+	return presets
+}
+
+// current は現在使われている設定
+var current = Default()
+
+// Current は現在使われている設定を返す
+func Current() *Config {
+	return current
+}
+
+// Set は使用する設定を差し替える（ゲームループと同じゴルーチンから呼ぶこと）
+func Set(c *Config) {
+	current = c
+}
+
+// Parse は既定の設定を基に、JSONに書かれた項目だけを上書きした設定を返す
+func Parse(data []byte) (*Config, error) {
+	c := Default()
+	
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(c); err != nil {
+		return nil, fmt.Errorf("設定を解析できません: %w", err)
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Load はファイルから設定を読み込む
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return c, nil
+}
+
+// Validate は設定値が正しいかどうかを検証し、問題をまとめて返す
+func (c *Config) Validate() error {
+	var problems []string
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			problems = append(problems, fmt.Sprintf(format, args...))
+		}
+	}
+	
+	check(c.Player.Size > 0, "player.size は正の値である必要があります（%g）", c.Player.Size)
+	check(c.Bullet.Size > 0, "bullet.size は正の値である必要があります（%g）", c.Bullet.Size)
+	check(c.Bullet.SpeedMin > 0, "bullet.speed_min は正の値である必要があります（%g）", c.Bullet.SpeedMin)
+	check(c.Bullet.SpeedMax >= c.Bullet.SpeedMin, "bullet.speed_max (%g) は bullet.speed_min (%g) 以上である必要があります", c.Bullet.SpeedMax, c.Bullet.SpeedMin)
+	check(c.Bullet.Initial >= 0, "bullet.initial は0以上である必要があります（%d）", c.Bullet.Initial)
+	check(c.Shield.Durability > 0, "shield.durability は1以上である必要があります（%d）", c.Shield.Durability)
+	check(c.Shield.ItemSize > 0, "shield.item_size は正の値である必要があります（%g）", c.Shield.ItemSize)
+	check(c.ScoreItem.Size > 0, "score_item.size は正の値である必要があります（%g）", c.ScoreItem.Size)
+	check(c.ScoreItem.SpeedMax > 0, "score_item.speed_max は正の値である必要があります（%g）", c.ScoreItem.SpeedMax)
+	
+	check(c.Items.Size > 0, "items.size は正の値である必要があります（%g）", c.Items.Size)
+	check(c.Items.SpawnJitter >= 0, "items.spawn_jitter は0以上である必要があります（%g）", c.Items.SpawnJitter)
+	check(c.Items.Max >= 0, "items.max は0以上である必要があります（%d）", c.Items.Max)
+	check(c.Items.BlinkTime >= 0, "items.blink_time は0以上である必要があります（%g）", c.Items.BlinkTime)
+	check(c.Items.ShrinkScale > 0 && c.Items.ShrinkScale <= 1, "items.shrink_scale は0より大きく1以下である必要があります（%g）", c.Items.ShrinkScale)
+	specs := []struct {
+		name string
+		spec ItemSpec
+	}{
+		{"shield", c.Items.Shield}, {"bomb_refill", c.Items.BombRefill}, {"slow_motion", c.Items.SlowMotion},
+		{"magnet", c.Items.Magnet}, {"score_gem", c.Items.ScoreGem}, {"shrink", c.Items.Shrink}, {"invincible", c.Items.Invincible},
+	}
+	totalWeight, otherWeight := 0.0, 0.0
+	for _, s := range specs {
+		check(s.spec.Weight >= 0, "items.%s.weight は0以上である必要があります（%g）", s.name, s.spec.Weight)
+		check(s.spec.Lifetime > 0, "items.%s.lifetime は正の値である必要があります（%g）", s.name, s.spec.Lifetime)
+		check(s.spec.Duration >= 0, "items.%s.duration は0以上である必要があります（%g）", s.name, s.spec.Duration)
+		totalWeight += s.spec.Weight
+		if s.name != "shield" {
+			otherWeight += s.spec.Weight
+		}
+	}
+	check(totalWeight > 0, "items の weight の合計が0です。少なくとも1種類のアイテムに正の weight を設定してください")
+	check(totalWeight <= 0 || otherWeight > 0, "シールド以外の items の weight の合計が0です。シールドなしのプレイや shield_weight_scale が0の難易度でアイテムが出現しなくなるため、シールド以外にも正の weight を設定してください")
+	
+	check(c.SlowMotion.Factor >= 0 && c.SlowMotion.Factor <= 1, "slow_motion.factor は0以上1以下である必要があります（%g）", c.SlowMotion.Factor)
+	check(c.SlowMotion.SkillDuration > 0, "slow_motion.skill_duration は正の値である必要があります（%g）", c.SlowMotion.SkillDuration)
+	check(c.SlowMotion.SkillCooldown > 0, "slow_motion.skill_cooldown は正の値である必要があります（%g）", c.SlowMotion.SkillCooldown)
+	check(c.SlowMotion.Saturation >= 0 && c.SlowMotion.Saturation <= 1, "slow_motion.saturation は0以上1以下である必要があります（%g）", c.SlowMotion.Saturation)
+	check(c.Graze.Margin >= 0, "graze.margin は0以上である必要があります（%g）", c.Graze.Margin)
+	
+	bombs := []struct {
+		name    string
+		variant BombVariant
+	}{
+		{"radial", c.Bombs.Radial}, {"line_sweep", c.Bombs.LineSweep}, {"time_stop", c.Bombs.TimeStop},
+	}
+	for _, b := range bombs {
+		check(b.variant.Duration > 0, "bombs.%s.duration は正の値である必要があります（%g）", b.name, b.variant.Duration)
+		check(b.variant.Cooldown > 0, "bombs.%s.cooldown は正の値である必要があります（%g）", b.name, b.variant.Cooldown)
+	}
+	check(c.Bombs.Radial.Radius > 0, "bombs.radial.radius は正の値である必要があります（%g）", c.Bombs.Radial.Radius)
+	
+	presets := []struct {
+		name   string
+		preset DifficultyPreset
+	}{
+		{"easy", c.Difficulty.Easy}, {"normal", c.Difficulty.Normal}, {"hard", c.Difficulty.Hard}, {"lunatic", c.Difficulty.Lunatic},
+	}
+	for _, p := range presets {
+		check(p.preset.StartLevel >= 1, "difficulty.%s.start_level は1以上である必要があります（%d）", p.name, p.preset.StartLevel)
+		check(p.preset.LevelUpInterval > 0, "difficulty.%s.level_up_interval は正の値である必要があります（%g）", p.name, p.preset.LevelUpInterval)
+		check(p.preset.ItemSpawnInterval > c.Items.SpawnJitter, "difficulty.%s.item_spawn_interval (%g) は items.spawn_jitter (%g) より大きい必要があります", p.name, p.preset.ItemSpawnInterval, c.Items.SpawnJitter)
+		check(p.preset.ShieldWeightScale >= 0, "difficulty.%s.shield_weight_scale は0以上である必要があります（%g）", p.name, p.preset.ShieldWeightScale)
+		check(p.preset.BombCooldownScale > 0, "difficulty.%s.bomb_cooldown_scale は正の値である必要があります（%g）", p.name, p.preset.BombCooldownScale)
+	}
+	
+	s := c.Spawn
+	check(s.Candidates >= 1, "spawn.candidates は1以上である必要があります（%d）", s.Candidates)
+	check(s.MinBulletDistance >= 0, "spawn.min_bullet_distance は0以上である必要があります（%g）", s.MinBulletDistance)
+	check(s.MinPlayerDistance >= 0, "spawn.min_player_distance は0以上である必要があります（%g）", s.MinPlayerDistance)
+	check(s.PredictSeconds >= 0, "spawn.predict_seconds は0以上である必要があります（%g）", s.PredictSeconds)
+	check(s.PredictStep >= 1, "spawn.predict_step は1以上である必要があります（%d）", s.PredictStep)
+	check(s.DensityRadius >= 0, "spawn.density_radius は0以上である必要があります（%g）", s.DensityRadius)
+	check(s.RetryDelay > 0, "spawn.retry_delay は正の値である必要があります（%g）", s.RetryDelay)
+	
+	d := c.DDA
+	check(d.EvalInterval > 0, "dda.eval_interval は正の値である必要があります（%g）", d.EvalInterval)
+	check(d.Step >= 0, "dda.step は0以上である必要があります（%g）", d.Step)
+	check(d.MinFactor > 0 && d.MinFactor <= 1, "dda.min_factor は0より大きく1以下である必要があります（%g）", d.MinFactor)
+	check(d.MaxFactor >= 1, "dda.max_factor は1以上である必要があります（%g）", d.MaxFactor)
+	check(d.StressLow <= d.StressHigh, "dda.stress_low (%g) は dda.stress_high (%g) 以下である必要があります", d.StressLow, d.StressHigh)
+	check(d.TargetSurvival > 0, "dda.target_survival は正の値である必要があります（%g）", d.TargetSurvival)
+	check(d.RecentRuns >= 1, "dda.recent_runs は1以上である必要があります（%d）", d.RecentRuns)
+	
+	if len(problems) > 0 {
+		return errors.New("設定が不正です:\n  " + strings.Join(problems, "\n  "))
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDefaultIsValid(t *testing.T) {
+	if err := Default().Validate(); err != nil {
+		t.Fatalf("既定の設定が不正です: %v", err)
+	}
+}
+
+func TestValidateRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(c *Config)
+		want   string // エラーに含まれるはずの項目名
+	}{
+		{"プレイヤーのサイズが0", func(c *Config) { c.Player.Size = 0 }, "player.size"},
+		{"弾速の上限が下限より小さい", func(c *Config) { c.Bullet.SpeedMax = c.Bullet.SpeedMin - 1 }, "bullet.speed_max"},
+		{"シールドの耐久値が0", func(c *Config) { c.Shield.Durability = 0 }, "shield.durability"},
+		{"縮小の倍率が1より大きい", func(c *Config) { c.Items.ShrinkScale = 1.5 }, "items.shrink_scale"},
+		{"アイテムの重みが負", func(c *Config) { c.Items.Magnet.Weight = -1 }, "items.magnet.weight"},
+		{"アイテムの寿命が0", func(c *Config) { c.Items.ScoreGem.Lifetime = 0 }, "items.score_gem.lifetime"},
+		{"スロースキルのクールダウンが0", func(c *Config) { c.SlowMotion.SkillCooldown = 0 }, "slow_motion.skill_cooldown"},
+		{"ボムのクールダウンが0", func(c *Config) { c.Bombs.TimeStop.Cooldown = 0 }, "bombs.time_stop.cooldown"},
+		{"ボムのクールダウンの倍率が0", func(c *Config) { c.Difficulty.Hard.BombCooldownScale = 0 }, "difficulty.hard.bomb_cooldown_scale"},
+		{"開始レベルが0", func(c *Config) { c.Difficulty.Easy.StartLevel = 0 }, "difficulty.easy.start_level"},
+		{"アイテムの出現間隔が揺らぎ以下", func(c *Config) { c.Difficulty.Lunatic.ItemSpawnInterval = c.Items.SpawnJitter }, "difficulty.lunatic.item_spawn_interval"},
+		{"候補位置の数が0", func(c *Config) { c.Spawn.Candidates = 0 }, "spawn.candidates"},
+		{"DDAの係数の下限が0", func(c *Config) { c.DDA.MinFactor = 0 }, "dda.min_factor"},
+		{"DDAの負荷のしきい値が逆", func(c *Config) { c.DDA.StressLow = c.DDA.StressHigh + 1 }, "dda.stress_low"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Default()
+			tt.modify(c)
+			err := c.Validate()
+			if err == nil {
+				t.Fatal("エラーになりませんでした")
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("エラーに %s が含まれていません: %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestValidateItemWeights(t *testing.T) {
+	others := func(c *Config) []*ItemSpec {
+		return []*ItemSpec{&c.Items.BombRefill, &c.Items.SlowMotion, &c.Items.Magnet, &c.Items.ScoreGem, &c.Items.Shrink, &c.Items.Invincible}
+	}
+	tests := []struct {
+		name   string
+		modify func(c *Config)
+		want   string // エラーに含まれるはずの文（空なら正しい設定）
+	}{
+		{"シールドだけに重みがある", func(c *Config) {
+			for _, spec := range others(c) {
+				spec.Weight = 0
+			}
+		}, "シールド以外"},
+		{"すべての重みが0", func(c *Config) {
+			c.Items.Shield.Weight = 0
+			for _, spec := range others(c) {
+				spec.Weight = 0
+			}
+		}, "weight の合計が0"},
+		{"シールドの重みだけが0", func(c *Config) { c.Items.Shield.Weight = 0 }, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Default()
+			tt.modify(c)
+			err := c.Validate()
+			switch {
+			case tt.want == "" && err != nil:
+				t.Errorf("正しい設定がエラーになりました: %v", err)
+			case tt.want != "" && err == nil:
+				t.Error("エラーになりませんでした")
+			case tt.want != "" && !strings.Contains(err.Error(), tt.want):
+				t.Errorf("エラーに %s が含まれていません: %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	c, err := Parse([]byte(`{"player": {"size": 12}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Player.Size != 12 {
+		t.Errorf("player.size = %g, want 12", c.Player.Size)
+	}
+	if c.Bullet.Size != BulletSize {
+		t.Errorf("書かれていない bullet.size が既定値になっていません: %g", c.Bullet.Size)
+	}
+
+	if _, err := Parse([]byte(`{"player": {"radius": 12}}`)); err == nil {
+		t.Error("知らない項目がエラーになりませんでした")
+	}
+	if _, err := Parse([]byte(`{"bombs": {"radial": {"cooldown": 0}}}`)); err == nil {
+		t.Error("不正な値がエラーになりませんでした")
+	}
+}
@@ -11,6 +11,7 @@ const (
 	PlayerSize    = 10
 	BulletSize    = 8
 	ShieldItemSize = 15
+	ScoreItemSize  = 4
 )
 
 // ゲームロジック関連
@@ -18,14 +19,220 @@ const (
 	InitialBullets   = 20
 	BulletSpeedMin   = 2.0
 	BulletSpeedMax   = 5.0
-	BulletSpawnRate  = 5  // 1秒あたりの新しい弾の数
 	MaxRankingScores = 5  // ランキングに表示するスコア数
 	
 	// シールド関連の定数
 	ShieldDurability = 3     // シールドの耐久値
-	ShieldSpawnRate  = 0.05  // シールドアイテムの出現確率（1フレームあたり）
+	
+	// 得点アイテム関連の定数
+	ScoreItemValue    = 10   // 得点アイテム1個あたりの得点
+	ScoreItemSpeedMax = 12.0 // 得点アイテムがプレイヤーに向かう最高速度
+)
+
+// アイテム関連
+const (
+	ItemSize          = 12   // シールド以外のアイテムのサイズ
+	ItemSpawnInterval = 3.0  // アイテム出現の平均間隔（秒）
+	ItemSpawnJitter   = 1.5  // 出現間隔のランダムな揺らぎ（秒）
+	MaxItems          = 4    // 同時に画面上に存在できるアイテムの数
+	ItemBlinkTime     = 2.0  // 消滅前に点滅を始める残り時間（秒）
+	
+	ScoreGemValue     = 100  // 宝石アイテムの得点
+	SlowMotionFactor  = 0.4  // スローモーション中の弾の速度・発生頻度の倍率
+	SlowSkillDuration = 3.0  // スロースキルの効果時間（秒）
+	SlowSkillCooldown = 20.0 // スロースキルのクールダウン（秒）
+	SlowMotionSaturation = 0.25 // スローモーション中の画面の彩度
+	MagnetRadius      = 200.0 // マグネットがアイテムを引き寄せる範囲
+	MagnetPullSpeed   = 4.0  // マグネットがアイテムを引き寄せる速度
+	ShrinkScale       = 0.5  // 縮小中のプレイヤーサイズの倍率
+)
+
+// アイテムの種類ごとの出現の重み・画面上に留まる時間（秒）・取得後の効果時間（秒）
+const (
+	ShieldItemWeight       = 30.0
+	ShieldItemLifetime     = 10.0
+	BombRefillItemWeight   = 10.0
+	BombRefillItemLifetime = 8.0
+	SlowMotionItemWeight   = 12.0
+	SlowMotionItemLifetime = 8.0
+	SlowMotionItemDuration = 5.0
+	MagnetItemWeight       = 12.0
+	MagnetItemLifetime     = 8.0
+	MagnetItemDuration     = 8.0
+	ScoreGemItemWeight     = 25.0
+	ScoreGemItemLifetime   = 6.0
+	ShrinkItemWeight       = 8.0
+	ShrinkItemLifetime     = 8.0
+	ShrinkItemDuration     = 6.0
+	InvincibleItemWeight   = 3.0
+	InvincibleItemLifetime = 6.0
+	InvincibleItemDuration = 4.0
 )
 
+// ボムの種類ごとの爆発の半径・効果の持続時間（秒）・クールダウン（秒）
+const (
+	RadialBombRadius    = 150.0
+	RadialBombDuration  = 0.5
+	RadialBombCooldown  = 10.0
+	LineSweepDuration   = 1.0
+	LineSweepCooldown   = 15.0
+	TimeStopDuration    = 3.0
+	TimeStopCooldown    = 20.0
+)
+
+// 難易度プリセットごとの開始レベル・レベルが上がる間隔（秒）・アイテム出現の平均間隔（秒）・シールドの出現の重みの倍率・ボムのクールダウンの倍率
+const (
+	EasyStartLevel           = 1
+	EasyLevelUpInterval      = 10.0
+	EasyItemSpawnInterval    = 2.5
+	EasyShieldWeightScale    = 1.5
+	EasyBombCooldownScale    = 0.7
+	NormalStartLevel         = 1
+	NormalLevelUpInterval    = 6.0
+	NormalItemSpawnInterval  = ItemSpawnInterval
+	NormalShieldWeightScale  = 1.0
+	NormalBombCooldownScale  = 1.0
+	HardStartLevel           = 3
+	HardLevelUpInterval      = 5.0
+	HardItemSpawnInterval    = 3.5
+	HardShieldWeightScale    = 0.7
+	HardBombCooldownScale    = 1.2
+	LunaticStartLevel        = 5
+	LunaticLevelUpInterval   = 4.0
+	LunaticItemSpawnInterval = 4.0
+	LunaticShieldWeightScale = 0.4
+	LunaticBombCooldownScale = 1.5
+)
+
+// 出現位置の選定関連
+const (
+	SpawnCandidates        = 16    // 1回の配置で評価する候補位置の数
+	SpawnMinBulletDistance = 40.0  // 弾（予測位置を含む）からの最低距離
+	SpawnMinPlayerDistance = 80.0  // プレイヤーからの最低距離
+	SpawnPredictSeconds    = 1.0   // 弾の動きを予測する時間（秒）
+	SpawnPredictStep       = 6     // 予測で位置を調べるフレーム間隔
+	SpawnDensityRadius     = 60.0  // 弾の密度を数える範囲
+	SpawnRetryDelay        = 0.25  // 安全な位置が見つからなかった場合の再試行までの時間（秒）
+)
+
+// 弾の振る舞い関連
+const (
+	BulletSpeedMedium = 3.0  // この速さ（1フレームあたりのピクセル）以上の弾を中速とする
+	BulletSpeedFast   = 5.0  // この速さ以上の弾を高速とする
+	HomingTurnRate    = 0.03 // 誘導弾が1フレームに曲がれる角度（ラジアン）
+	HomingDuration    = 90.0 // 誘導弾が誘導を続けるフレーム数
+	SplitDelay        = 45.0 // 分裂弾が分裂するまでのフレーム数
+	SplitCount        = 6    // 分裂弾が分かれる弾の数
+	SplitSizeScale    = 0.75 // 分かれた弾の大きさの倍率
+)
+
+// グレイズ（弾がプレイヤーをかすめること）関連
+const (
+	GrazeMargin = 12.0 // 当たり判定の外側でグレイズとみなす距離
+)
+
+// パーティクル関連
+const (
+	MaxParticles        = 4096 // 同時に存在できる粒子の数
+	CancelSparkCount    = 3    // ボムで消した弾1個あたりの火花の数
+	ShieldSparkCount    = 24   // シールドが弾を防いだときの火花の数
+	PickupBurstCount    = 16   // アイテムを取ったときの粒子の数
+	DeathBlastCount     = 120  // プレイヤーがやられたときの閃光の粒子の数
+	DeathDebrisDuration = 0.6  // プレイヤーがやられた後に破片を出し続ける時間（秒）
+)
+
+// 画面の揺れ・ヒットストップ・フラッシュ関連
+const (
+	ShakeMaxOffset = 12.0 // 最も強い揺れでの画面のずれ（ピクセル）
+	ShakeDecay     = 2.5  // 揺れの強さが1秒あたりに減る量
+	
+	ShieldHitShake   = 0.35 // シールド被弾時の揺れの強さ
+	ShieldHitStop    = 4    // シールド被弾時のヒットストップ（フレーム）
+	ShieldHitFlash   = 0.15 // シールド被弾時のフラッシュの時間（秒）
+	BombShake        = 0.25 // ボム使用時の揺れの強さ
+	BombFlash        = 0.2  // ボム使用時のフラッシュの時間（秒）
+	DeathShake       = 0.8  // やられたときの揺れの強さ
+	DeathHitStop     = 12   // やられたときのヒットストップ（フレーム）
+	DeathFlash       = 0.5  // やられたときのフラッシュの時間（秒）
+)
+
+// サウンド関連
+const (
+	MaxSFXVoices       = 16  // 同時に鳴らせる効果音の数
+	MusicCrossfade     = 1.5 // BGMを切り替えるときのクロスフェードの時間（秒）
+	DefaultMusicVolume = 0.6 // BGMの音量の初期値（0〜1）
+	DefaultSFXVolume   = 0.8 // 効果音の音量の初期値（0〜1）
+	VolumeStep         = 0.1 // 設定画面で1回に変える音量
+)
+
+// 練習モード関連
+const (
+	PracticeMaxLevel   = 30  // 練習モードで選べる開始レベルの上限
+	MissInvincibleTime = 2.0 // 残機無制限のプレイで被弾した後の無敵時間（秒）
+)
+
+// デイリーチャレンジ関連
+const (
+	DailyPreset          = DifficultyNormal // デイリーチャレンジの難易度プリセット
+	DailyFastBulletScale = 1.3              // 「弾が速い」ルールでの弾速の倍率
+	DailyRankingScores   = 5                // ゲームオーバー画面に表示するデイリーチャレンジの記録の数
+)
+
+// デバッグ表示・コンソール関連（開発用ビルドのみ）
+const (
+	DebugStatsInterval  = 30 // メモリの統計を取り直す間隔（フレーム）
+	DebugConsoleLines   = 12 // コンソールに表示する出力の行数
+	DebugConsoleHistory = 32 // コンソールに残す入力履歴の数
+)
+
+// 動的難易度調整（アシストモード）関連
+const (
+	DDAEvalInterval    = 5.0  // 負荷を評価する間隔（秒）
+	DDAStep            = 0.05 // 1回の評価で難易度係数を動かす量
+	DDAMinFactor       = 0.6  // 難易度係数の下限
+	DDAMaxFactor       = 1.2  // 難易度係数の上限
+	DDANearMissWeight  = 0.1  // ニアミス1回あたりの負荷
+	DDAShieldHitWeight = 1.0  // シールド被弾1回あたりの負荷
+	DDABombWeight      = 0.8  // ボム使用1回あたりの負荷
+	DDAStressHigh      = 2.0  // これより負荷が高ければ緩める
+	DDAStressLow       = 0.5  // これより負荷が低ければ強める
+	DDATargetSurvival  = 60.0 // 目標とする生存時間（秒）
+	DDARecentRuns      = 3    // 開始時の係数を決めるのに使う直近のプレイ数
+)
+
+// DifficultyKind は難易度プリセットの種類
+type DifficultyKind int
+
+// 難易度プリセットの種類
+const (
+	DifficultyEasy DifficultyKind = iota
+	DifficultyNormal
+	DifficultyHard
+	DifficultyLunatic
+)
+
+// DifficultyCount は難易度プリセットの数
+const DifficultyCount = 4
+
+// DefaultDifficulty はタイトル画面で最初に選択されている難易度
+const DefaultDifficulty = DifficultyNormal
+
+// BombKind はボムの種類
+type BombKind int
+
+// ボムの種類
+const (
+	BombRadial    BombKind = iota // プレイヤー中心に広がる円形の爆発
+	BombLineSweep                 // 画面上端から下端へ走る横一線の掃射
+	BombTimeStop                  // 一定時間すべての弾を停止させる
+)
+
+// BombKindCount はボムの種類の数
+const BombKindCount = 3
+
+// DefaultBombKind はゲーム開始時のボムの種類
+const DefaultBombKind = BombRadial
+
 // 時間関連
 const (
 	DeltaTime = 0.016  // 1フレームあたりの時間（約60FPS）
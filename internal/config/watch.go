@@ -0,0 +1,83 @@
+package config
+
+import (
+	"log/slog"
+	"os"
+	"time"
+)
+
+// Watcher は設定ファイルの変更を監視し、読み込み直した設定を通知する
+// 開発中にゲームを止めずにバランスを調整するためのもの
+type Watcher struct {
+	path     string
+	interval time.Duration
+	updates  chan *Config
+	done     chan struct{}
+}
+
+// NewWatcher は設定ファイルの監視を開始する
+func NewWatcher(path string, interval time.Duration) *Watcher {
+	w := &Watcher{
+		path:     path,
+		interval: interval,
+		updates:  make(chan *Config, 1),
+		done:     make(chan struct{}),
+	}
+	go w.run()
+	return w
+}
+
+// Updates は読み込み直した設定を受け取るチャネルを返す
+// 検証に失敗した設定は通知されず、ログに理由が出力される
+func (w *Watcher) Updates() <-chan *Config {
+	return w.updates
+}
+
+// Close は監視を終了する
+func (w *Watcher) Close() {
+	close(w.done)
+}
+
+// run は更新日時を定期的に確認し、変わっていれば読み込み直す
+func (w *Watcher) run() {
+	lastMod := w.modTime()
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	
+	for {
+		select {
+		case <-w.done:
+			return
+		case <-ticker.C:
+		}
+		
+		mod := w.modTime()
+		if mod.IsZero() || mod.Equal(lastMod) {
+			continue
+		}
+		lastMod = mod
+		
+		c, err := Load(w.path)
+		if err != nil {
+			slog.Warn("設定の再読み込みに失敗しました（前の設定を使い続けます）", "path", w.path, "err", err)
+			continue
+		}
+		slog.Info("設定を再読み込みしました", "path", w.path)
+		
+		// 未適用の古い設定は新しいものに置き換える
+		select {
+		case <-w.updates:
+		default:
+		}
+		w.updates <- c
+	}
+}
+
+// modTime は設定ファイルの更新日時を返す（取得できなければゼロ値）
+func (w *Watcher) modTime() time.Time {
+	info, err := os.Stat(w.path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
@@ -0,0 +1,61 @@
+// Package daily は日付から決まるデイリーチャレンジと、その結果の記録を扱う
+package daily
+
+import (
+	"hash/fnv"
+	"math/rand"
+	"time"
+)
+
+// DateLayout は日付の表記（記録の保存にも使う）
+const DateLayout = "2006-01-02"
+
+// Modifier はデイリーチャレンジのルールの変化の名前（メッセージのキーにも使う）
+type Modifier string
+
+// ルールの変化
+const (
+	FastBullets Modifier = "fast_bullets" // 弾が速い
+	NoShields   Modifier = "no_shields"   // シールドが出現しない
+	DoubleGraze Modifier = "double_graze" // グレイズを2倍に数える
+)
+
+// Modifiers はルールの変化の一覧（抽選の順番を安定させるため固定の並び）
+var Modifiers = []Modifier{FastBullets, NoShields, DoubleGraze}
+
+// Challenge は1日分のデイリーチャレンジ
+type Challenge struct {
+	Date      string     // 日付（DateLayout の表記）
+	Seed      int64      // 乱数のシード（同じ日なら誰でも同じ弾幕になる）
+	Modifiers []Modifier // ルールの変化（1つ以上）
+}
+
+// For は t の日付（t のタイムゾーンでの暦日）のデイリーチャレンジを返す
+func For(t time.Time) Challenge {
+	date := t.Format(DateLayout)
+	seed := Seed(date)
+	return Challenge{Date: date, Seed: seed, Modifiers: pick(seed)}
+}
+
+// Seed は日付の表記から乱数のシードを作る
+func Seed(date string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(date))
+	return int64(h.Sum64() &^ (1 << 63))
+}
+
+// pick はシードからルールの変化を1つ以上選ぶ（それぞれ半々の確率で選び、1つも選ばれなければ1つ選ぶ）
+// 弾幕に使う乱数とは別の乱数から選ぶため、ルールの変化を増やしても弾幕の並びは変わらない
+func pick(seed int64) []Modifier {
+	rng := rand.New(rand.NewSource(seed ^ 0x5eed))
+	var picked []Modifier
+	for _, m := range Modifiers {
+		if rng.Intn(2) == 0 {
+			picked = append(picked, m)
+		}
+	}
+	if len(picked) == 0 {
+		picked = append(picked, Modifiers[rng.Intn(len(Modifiers))])
+	}
+	return picked
+}
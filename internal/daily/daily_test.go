@@ -0,0 +1,58 @@
+package daily
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFor(t *testing.T) {
+	// 同じ暦日なら時刻によらず同じチャレンジになる
+	morning := For(time.Date(2026, 3, 14, 0, 0, 1, 0, time.UTC))
+	night := For(time.Date(2026, 3, 14, 23, 59, 59, 0, time.UTC))
+	if morning.Date != "2026-03-14" {
+		t.Errorf("Date = %s, want 2026-03-14", morning.Date)
+	}
+	if morning.Seed != night.Seed || !sameModifiers(morning.Modifiers, night.Modifiers) {
+		t.Errorf("同じ日のチャレンジが異なります: %+v, %+v", morning, night)
+	}
+
+	// 暦日はタイムゾーンで決まる
+	tokyo := time.FixedZone("JST", 9*60*60)
+	if c := For(time.Date(2026, 3, 14, 20, 0, 0, 0, time.UTC).In(tokyo)); c.Date != "2026-03-15" {
+		t.Errorf("Date = %s, want 2026-03-15", c.Date)
+	}
+}
+
+func TestSeed(t *testing.T) {
+	if Seed("2026-03-14") != Seed("2026-03-14") {
+		t.Error("同じ日付のシードが異なります")
+	}
+	if Seed("2026-03-14") == Seed("2026-03-15") {
+		t.Error("異なる日付のシードが同じです")
+	}
+
+	// シードは負にならず、ルールの変化は必ず1つ以上選ばれる
+	day := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 366; i++ {
+		c := For(day.AddDate(0, 0, i))
+		if c.Seed < 0 {
+			t.Errorf("%s: シードが負です（%d）", c.Date, c.Seed)
+		}
+		if len(c.Modifiers) == 0 {
+			t.Errorf("%s: ルールの変化が選ばれていません", c.Date)
+		}
+	}
+}
+
+// sameModifiers はルールの変化の並びが同じかどうかを返す
+func sameModifiers(a, b []Modifier) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,193 @@
+package daily
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// Record は1日1回のランキング対象の挑戦の結果
+// 挑戦を始めた時点で記録するため、途中でやめた挑戦も0秒の結果として残る
+type Record struct {
+	Date     string  `json:"date"`
+	Time     float64 `json:"time"` // 生存時間（秒）
+	Score    int     `json:"score"`
+	Grazes   int     `json:"grazes"`
+	Finished bool    `json:"finished"` // ゲームオーバーまで遊んだかどうか
+}
+
+// Streak は毎日続けて挑戦した期間
+type Streak struct {
+	Start, End string // 最初と最後の日付
+	Days       int
+}
+
+// History はデイリーチャレンジの挑戦の記録（ローカルのファイルに保存する）
+type History struct {
+	Records []Record `json:"records"` // 日付の昇順
+	Err     error    `json:"-"`       // 記録を保存できない理由（nil でなければ画面に知らせる）
+	path    string   // 保存先（空なら保存しない）
+}
+
+// DefaultPath は記録の既定の保存先を返す（ユーザーの設定ディレクトリの下）
+func DefaultPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("デイリーチャレンジの記録の保存先を決められません: %w", err)
+	}
+	return filepath.Join(dir, "bullet-dodge", "daily.json"), nil
+}
+
+// Open は path から記録を読み込む（ファイルがなければ空の記録を返し、最初の保存で作成する）
+// path が空の場合と、読み込めなかった場合は保存しない記録を返す（読めないファイルを上書きして過去の記録を失わないため）
+// 読み込めなかった場合は、返す記録の Err にも同じエラーを入れる
+func Open(path string) (*History, error) {
+	h := &History{path: path}
+	if path == "" {
+		return h, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return h, nil
+	}
+	if err != nil {
+		err = fmt.Errorf("デイリーチャレンジの記録 %s を読み込めません: %w", path, err)
+		return &History{Err: err}, err
+	}
+	if err := json.Unmarshal(data, h); err != nil {
+		err = fmt.Errorf("デイリーチャレンジの記録 %s の形式が正しくありません: %w", path, err)
+		return &History{Err: err}, err
+	}
+	sort.SliceStable(h.Records, func(i, j int) bool { return h.Records[i].Date < h.Records[j].Date })
+	return h, nil
+}
+
+// Save は記録をファイルに書き出す（失敗したら Err に残し、次に成功すれば消す）
+func (h *History) Save() error {
+	if h.path == "" {
+		return nil
+	}
+	h.Err = h.write()
+	return h.Err
+}
+
+// write は記録を path に書き出す
+func (h *History) write() error {
+	data, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(h.path), 0o755); err != nil {
+		return fmt.Errorf("デイリーチャレンジの記録を保存できません: %w", err)
+	}
+	if err := os.WriteFile(h.path, data, 0o644); err != nil {
+		return fmt.Errorf("デイリーチャレンジの記録を保存できません: %w", err)
+	}
+	return nil
+}
+
+// Attempted は date の日にランキング対象の挑戦をしたかどうかを返す
+func (h *History) Attempted(date string) bool {
+	_, ok := h.find(date)
+	return ok
+}
+
+// Get は date の日の結果を返す（挑戦していなければokはfalse）
+func (h *History) Get(date string) (Record, bool) {
+	i, ok := h.find(date)
+	if !ok {
+		return Record{}, false
+	}
+	return h.Records[i], true
+}
+
+// Begin は date の日のランキング対象の挑戦を始めたことを記録する
+func (h *History) Begin(date string) {
+	if h.Attempted(date) {
+		return
+	}
+	h.Records = append(h.Records, Record{Date: date})
+	sort.SliceStable(h.Records, func(i, j int) bool { return h.Records[i].Date < h.Records[j].Date })
+}
+
+// Finish は date の日の挑戦の結果を記録する
+func (h *History) Finish(date string, survived float64, score, grazes int) {
+	i, ok := h.find(date)
+	if !ok {
+		return
+	}
+	h.Records[i] = Record{Date: date, Time: survived, Score: score, Grazes: grazes, Finished: true}
+}
+
+// find は date の日の結果の位置を返す
+func (h *History) find(date string) (int, bool) {
+	for i, r := range h.Records {
+		if r.Date == date {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// Top は生存時間の長い順に最大 n 件の結果を返す
+func (h *History) Top(n int) []Record {
+	records := append([]Record(nil), h.Records...)
+	sort.SliceStable(records, func(i, j int) bool { return records[i].Time > records[j].Time })
+	if len(records) > n {
+		records = records[:n]
+	}
+	return records
+}
+
+// Streaks は毎日続けて挑戦した期間の一覧を古い順に返す
+func (h *History) Streaks() []Streak {
+	var streaks []Streak
+	var prev time.Time
+	for _, r := range h.Records {
+		day, err := time.Parse(DateLayout, r.Date)
+		if err != nil {
+			continue
+		}
+		if n := len(streaks); n > 0 && day.Equal(prev.AddDate(0, 0, 1)) {
+			streaks[n-1].End = r.Date
+			streaks[n-1].Days++
+		} else {
+			streaks = append(streaks, Streak{Start: r.Date, End: r.Date, Days: 1})
+		}
+		prev = day
+	}
+	return streaks
+}
+
+// CurrentStreak は today の時点で続いている連続日数を返す
+// 今日まだ挑戦していなくても、昨日まで続いていれば途切れていないものとする
+func (h *History) CurrentStreak(today string) int {
+	streaks := h.Streaks()
+	if len(streaks) == 0 {
+		return 0
+	}
+	last := streaks[len(streaks)-1]
+	day, err := time.Parse(DateLayout, today)
+	if err != nil {
+		return 0
+	}
+	if last.End == today || last.End == day.AddDate(0, 0, -1).Format(DateLayout) {
+		return last.Days
+	}
+	return 0
+}
+
+// BestStreak は最も長く続いた連続日数を返す
+func (h *History) BestStreak() int {
+	best := 0
+	for _, s := range h.Streaks() {
+		best = max(best, s.Days)
+	}
+	return best
+}
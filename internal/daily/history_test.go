@@ -0,0 +1,178 @@
+package daily
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// history は指定した日付に挑戦した記録を返す
+func history(dates ...string) *History {
+	h := &History{}
+	for _, date := range dates {
+		h.Begin(date)
+	}
+	return h
+}
+
+func TestCurrentStreak(t *testing.T) {
+	tests := []struct {
+		name  string
+		dates []string
+		today string
+		want  int
+	}{
+		{"記録なし", nil, "2026-03-14", 0},
+		{"今日だけ", []string{"2026-03-14"}, "2026-03-14", 1},
+		{"昨日まで続いている", []string{"2026-03-12", "2026-03-13"}, "2026-03-14", 2},
+		{"今日まで続いている", []string{"2026-03-12", "2026-03-13", "2026-03-14"}, "2026-03-14", 3},
+		{"一昨日で途切れた", []string{"2026-03-11", "2026-03-12"}, "2026-03-14", 0},
+		{"途切れた後にまた始めた", []string{"2026-03-09", "2026-03-10", "2026-03-11", "2026-03-13", "2026-03-14"}, "2026-03-14", 2},
+		{"月をまたぐ", []string{"2026-02-27", "2026-02-28", "2026-03-01"}, "2026-03-01", 3},
+		{"年をまたぐ", []string{"2025-12-31", "2026-01-01"}, "2026-01-02", 2},
+		{"うるう日", []string{"2028-02-28", "2028-02-29", "2028-03-01"}, "2028-03-01", 3},
+		{"うるう年でない2月の翌日", []string{"2026-02-28", "2026-03-02"}, "2026-03-02", 1},
+		{"順不同に記録しても並べ替える", []string{"2026-03-14", "2026-03-12", "2026-03-13"}, "2026-03-14", 3},
+		{"今日の日付が不正", []string{"2026-03-14"}, "today", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := history(tt.dates...).CurrentStreak(tt.today); got != tt.want {
+				t.Errorf("CurrentStreak(%s) = %d, want %d", tt.today, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStreaks(t *testing.T) {
+	h := history("2026-03-01", "2026-03-02", "2026-03-03", "2026-03-05", "2026-03-07", "2026-03-08")
+	want := []Streak{
+		{Start: "2026-03-01", End: "2026-03-03", Days: 3},
+		{Start: "2026-03-05", End: "2026-03-05", Days: 1},
+		{Start: "2026-03-07", End: "2026-03-08", Days: 2},
+	}
+	got := h.Streaks()
+	if len(got) != len(want) {
+		t.Fatalf("Streaks() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Streaks()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+	if best := h.BestStreak(); best != 3 {
+		t.Errorf("BestStreak() = %d, want 3", best)
+	}
+	if best := history().BestStreak(); best != 0 {
+		t.Errorf("記録がない場合の BestStreak() = %d, want 0", best)
+	}
+}
+
+func TestBeginFinish(t *testing.T) {
+	h := history("2026-03-14")
+	h.Begin("2026-03-14") // 2回目の挑戦は記録しない
+	if len(h.Records) != 1 {
+		t.Fatalf("同じ日の挑戦が %d 件記録されています", len(h.Records))
+	}
+	h.Finish("2026-03-14", 42.5, 1200, 30)
+	h.Finish("2026-03-15", 10, 100, 1) // 始めていない日の結果は記録しない
+
+	r, ok := h.Get("2026-03-14")
+	if !ok || r != (Record{Date: "2026-03-14", Time: 42.5, Score: 1200, Grazes: 30, Finished: true}) {
+		t.Errorf("Get() = %+v, %v", r, ok)
+	}
+	if h.Attempted("2026-03-15") {
+		t.Error("始めていない日が挑戦済みになっています")
+	}
+}
+
+func TestTop(t *testing.T) {
+	h := history("2026-03-01", "2026-03-02", "2026-03-03")
+	h.Finish("2026-03-01", 10, 0, 0)
+	h.Finish("2026-03-02", 30, 0, 0)
+	h.Finish("2026-03-03", 20, 0, 0)
+
+	top := h.Top(2)
+	if len(top) != 2 || top[0].Date != "2026-03-02" || top[1].Date != "2026-03-03" {
+		t.Errorf("Top(2) = %+v", top)
+	}
+	if h.Records[0].Date != "2026-03-01" {
+		t.Error("Top が記録の並びを変えています")
+	}
+}
+
+func TestOpenSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sub", "daily.json")
+
+	// ファイルがなければ空の記録から始め、最初の保存で作成する
+	h, err := Open(path)
+	if err != nil || h.Err != nil {
+		t.Fatalf("Open() = %v, Err = %v", err, h.Err)
+	}
+	h.Begin("2026-03-14")
+	h.Finish("2026-03-14", 42.5, 1200, 30)
+	if err := h.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	reopened, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r, ok := reopened.Get("2026-03-14"); !ok || r.Score != 1200 {
+		t.Errorf("保存した記録を読み込めません: %+v", reopened.Records)
+	}
+}
+
+func TestOpenBrokenFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "daily.json")
+	broken := []byte(`{"records": [`)
+	if err := os.WriteFile(path, broken, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	h, err := Open(path)
+	if err == nil || h.Err == nil {
+		t.Fatalf("壊れたファイルがエラーになりませんでした: %v, Err = %v", err, h.Err)
+	}
+
+	// 読めなかったファイルは上書きしない
+	h.Begin("2026-03-14")
+	if err := h.Save(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != string(broken) {
+		t.Errorf("読めなかったファイルが上書きされました: %s", data)
+	}
+	if h.Err == nil {
+		t.Error("保存しない記録の Err が消えました")
+	}
+}
+
+func TestSaveError(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "sub")
+	h, err := Open(filepath.Join(dir, "daily.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 保存先のディレクトリと同じ名前のファイルがあるので書き込めない
+	if err := os.WriteFile(dir, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.Save(); err == nil || h.Err == nil {
+		t.Fatalf("保存できないのにエラーになりませんでした: %v, Err = %v", err, h.Err)
+	}
+
+	// 次に保存できれば Err は消える
+	if err := os.Remove(dir); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.Save(); err != nil || h.Err != nil {
+		t.Errorf("Save() = %v, Err = %v", err, h.Err)
+	}
+}
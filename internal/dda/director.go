@@ -0,0 +1,130 @@
+package dda
+
+import (
+	"fmt"
+	"math"
+
+	"game/internal/config"
+)
+
+// Adjustment は1回分の難易度調整の記録
+type Adjustment struct {
+	Time       float64 // 調整したプレイ時間（秒）
+	Stress     float64 // 評価したプレイヤーの負荷
+	Factor     float64 // 調整後の難易度係数
+	NearMisses int     // 評価期間中のニアミス数
+	ShieldHits int     // 評価期間中にシールドで防いだ数
+	Bombs      int     // 評価期間中のボム使用数
+}
+
+// String は調整の記録をログ向けの文字列にする
+func (a Adjustment) String() string {
+	return fmt.Sprintf("t=%.1fs stress=%.2f factor=%.2f (near=%d shield=%d bomb=%d)",
+		a.Time, a.Stress, a.Factor, a.NearMisses, a.ShieldHits, a.Bombs)
+}
+
+// Director はプレイヤーの様子に合わせて弾幕の強さを調整する
+// Factorが1より小さいと弾が遅く少なくなり、大きいと速く多くなる
+type Director struct {
+	Factor  float64      // 現在の難易度係数
+	History []Adjustment // このプレイでの調整履歴
+	
+	// 評価期間中の集計
+	nearMisses int
+	shieldHits int
+	bombs      int
+	elapsed    float64 // 前回の評価からの経過時間
+	
+	// 直近のプレイの生存時間（古い順）
+	recentRuns []float64
+}
+
+// NewDirector は新しい難易度調整器を作成する
+func NewDirector() *Director {
+	return &Director{Factor: 1.0}
+}
+
+// BeginRun は新しいプレイを始める
+// 直近のプレイで早く倒れていれば、緩めの係数から始める
+func (d *Director) BeginRun() {
+	d.History = nil
+	d.nearMisses, d.shieldHits, d.bombs = 0, 0, 0
+	d.elapsed = 0
+	
+	d.Factor = 1.0
+	if len(d.recentRuns) > 0 {
+		average := 0.0
+		for _, t := range d.recentRuns {
+			average += t
+		}
+		average /= float64(len(d.recentRuns))
+		
+		// 目標の生存時間に届いていないほど緩める
+		cfg := config.Current().DDA
+		shortfall := 1.0 - average/cfg.TargetSurvival
+		d.Factor = d.clamp(1.0 - math.Max(0, shortfall)*(1.0-cfg.MinFactor))
+	}
+}
+
+// RecordNearMiss は弾がプレイヤーをかすめたことを記録する
+func (d *Director) RecordNearMiss() {
+	d.nearMisses++
+}
+
+// RecordShieldHit はシールドで弾を防いだことを記録する
+func (d *Director) RecordShieldHit() {
+	d.shieldHits++
+}
+
+// RecordBomb はボムを使ったことを記録する
+func (d *Director) RecordBomb() {
+	d.bombs++
+}
+
+// RecordDeath はプレイの終了（生存時間）を記録する
+func (d *Director) RecordDeath(survived float64) {
+	d.recentRuns = append(d.recentRuns, survived)
+	cfg := config.Current().DDA
+	if len(d.recentRuns) > cfg.RecentRuns {
+		d.recentRuns = d.recentRuns[len(d.recentRuns)-cfg.RecentRuns:]
+	}
+}
+
+// Update は一定間隔でプレイヤーの負荷を評価し、難易度係数を少しずつ動かす
+func (d *Director) Update(deltaTime, currentTime float64) {
+	d.elapsed += deltaTime
+	cfg := config.Current().DDA
+	if d.elapsed < cfg.EvalInterval {
+		return
+	}
+	
+	// ニアミス・シールド被弾・ボム使用が多いほど追い詰められていると判断する
+	stress := float64(d.nearMisses)*cfg.NearMissWeight +
+		float64(d.shieldHits)*cfg.ShieldHitWeight +
+		float64(d.bombs)*cfg.BombWeight
+	
+	switch {
+	case stress > cfg.StressHigh:
+		d.Factor = d.clamp(d.Factor - cfg.Step)
+	case stress < cfg.StressLow:
+		d.Factor = d.clamp(d.Factor + cfg.Step)
+	}
+	
+	d.History = append(d.History, Adjustment{
+		Time:       currentTime,
+		Stress:     stress,
+		Factor:     d.Factor,
+		NearMisses: d.nearMisses,
+		ShieldHits: d.shieldHits,
+		Bombs:      d.bombs,
+	})
+	
+	d.nearMisses, d.shieldHits, d.bombs = 0, 0, 0
+	d.elapsed = 0
+}
+
+// clamp は難易度係数を許容範囲に収める
+func (d *Director) clamp(factor float64) float64 {
+	cfg := config.Current().DDA
+	return math.Max(cfg.MinFactor, math.Min(cfg.MaxFactor, factor))
+}
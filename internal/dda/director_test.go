@@ -0,0 +1,103 @@
+package dda
+
+import (
+	"math"
+	"testing"
+
+	"game/internal/config"
+)
+
+func TestUpdate(t *testing.T) {
+	cfg := config.Default().DDA
+	tests := []struct {
+		name       string
+		nearMisses int
+		shieldHits int
+		bombs      int
+		want       float64
+	}{
+		{"負荷が低ければ強める", 0, 0, 0, 1 + cfg.Step},
+		{"負荷が中くらいなら変えない", 10, 0, 0, 1},
+		{"負荷が高ければ緩める", 0, 2, 1, 1 - cfg.Step},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDirector()
+			for i := 0; i < tt.nearMisses; i++ {
+				d.RecordNearMiss()
+			}
+			for i := 0; i < tt.shieldHits; i++ {
+				d.RecordShieldHit()
+			}
+			for i := 0; i < tt.bombs; i++ {
+				d.RecordBomb()
+			}
+
+			// 評価の間隔に届くまでは変えない
+			d.Update(cfg.EvalInterval/2, cfg.EvalInterval/2)
+			if d.Factor != 1 || len(d.History) != 0 {
+				t.Fatalf("評価の間隔の前に調整しました: %+v", d.History)
+			}
+			d.Update(cfg.EvalInterval/2, cfg.EvalInterval)
+			if math.Abs(d.Factor-tt.want) > 1e-9 {
+				t.Errorf("Factor = %g, want %g", d.Factor, tt.want)
+			}
+			if len(d.History) != 1 || d.History[0].NearMisses != tt.nearMisses || d.History[0].ShieldHits != tt.shieldHits || d.History[0].Bombs != tt.bombs {
+				t.Errorf("History = %+v", d.History)
+			}
+		})
+	}
+}
+
+func TestFactorStaysInRange(t *testing.T) {
+	cfg := config.Default().DDA
+	d := NewDirector()
+	for i := 0; i < 100; i++ {
+		d.Update(cfg.EvalInterval, float64(i))
+	}
+	if d.Factor != cfg.MaxFactor {
+		t.Errorf("負荷がないまま続けた Factor = %g, want %g", d.Factor, cfg.MaxFactor)
+	}
+
+	for i := 0; i < 100; i++ {
+		d.RecordShieldHit()
+		d.RecordShieldHit()
+		d.RecordShieldHit()
+		d.Update(cfg.EvalInterval, float64(i))
+	}
+	if d.Factor != cfg.MinFactor {
+		t.Errorf("負荷が高いまま続けた Factor = %g, want %g", d.Factor, cfg.MinFactor)
+	}
+}
+
+func TestBeginRun(t *testing.T) {
+	cfg := config.Default().DDA
+	tests := []struct {
+		name string
+		runs []float64
+		want float64
+	}{
+		{"直近のプレイがない", nil, 1},
+		{"目標の生存時間に届いている", []float64{cfg.TargetSurvival * 2}, 1},
+		{"目標の半分", []float64{cfg.TargetSurvival / 2}, 1 - 0.5*(1-cfg.MinFactor)},
+		{"すぐに倒れた", []float64{0}, cfg.MinFactor},
+		{"直近のプレイだけを使う", append(make([]float64, 5), cfg.TargetSurvival, cfg.TargetSurvival, cfg.TargetSurvival), 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDirector()
+			for _, survived := range tt.runs {
+				d.RecordDeath(survived)
+			}
+			d.RecordNearMiss()
+			d.History = []Adjustment{{}}
+			d.BeginRun()
+			if math.Abs(d.Factor-tt.want) > 1e-9 {
+				t.Errorf("Factor = %g, want %g", d.Factor, tt.want)
+			}
+			if d.History != nil || d.nearMisses != 0 {
+				t.Error("前のプレイの集計が残っています")
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+//go:build !release
+
+package debug
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"game/internal/game"
+	"game/internal/pattern"
+)
+
+// command はデバッグコンソールのコマンド
+type command struct {
+	usage string
+	help  string
+	run   func(c *console, args []string) (string, error)
+}
+
+// commands は名前で引けるコマンドの一覧
+var commands = map[string]command{}
+
+// commandNames は登録順のコマンドの名前（help の表示順）
+var commandNames []string
+
+// registerCommand はコマンドを登録する
+func registerCommand(name string, cmd command) {
+	if _, exists := commands[name]; !exists {
+		commandNames = append(commandNames, name)
+	}
+	commands[name] = cmd
+}
+
+// errUsage は引数が足りないか正しくないことを表す
+var errUsage = errors.New("引数が正しくありません")
+
+func init() {
+	registerCommand("help", command{
+		usage: "help",
+		help:  "コマンドの一覧を表示する",
+		run: func(c *console, args []string) (string, error) {
+			lines := make([]string, 0, len(commandNames))
+			for _, name := range commandNames {
+				lines = append(lines, fmt.Sprintf("%-18s %s", commands[name].usage, commands[name].help))
+			}
+			return strings.Join(lines, "\n"), nil
+		},
+	})
+	registerCommand("clear", command{
+		usage: "clear",
+		help:  "出力を消す",
+		run: func(c *console, args []string) (string, error) {
+			c.lines = c.lines[:0]
+			return "", nil
+		},
+	})
+	registerCommand("difficulty", command{
+		usage: "difficulty N",
+		help:  "難易度レベルを N にする",
+		run: func(c *console, args []string) (string, error) {
+			level, err := intArg(args, 0)
+			if err != nil {
+				return "", err
+			}
+			c.game.SetDifficulty(level)
+			return fmt.Sprintf("難易度レベル %d", c.game.Difficulty), nil
+		},
+	})
+	registerCommand("spawn", command{
+		usage: "spawn [pattern] X",
+		help:  "弾幕パターン X を1回発射する（" + strings.Join(pattern.Names(), " / ") + "）",
+		run: func(c *console, args []string) (string, error) {
+			if len(args) > 0 && args[0] == "pattern" {
+				args = args[1:]
+			}
+			if len(args) != 1 {
+				return "", errUsage
+			}
+			if err := c.game.SpawnPattern(args[0]); err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("弾幕パターン %s を発射（画面の弾 %d 個）", args[0], len(c.game.Bullets)), nil
+		},
+	})
+	registerCommand("shield", command{
+		usage: "shield",
+		help:  "シールドを与える",
+		run: func(c *console, args []string) (string, error) {
+			c.game.GiveShield()
+			return fmt.Sprintf("シールド %d", c.game.Player.Shield), nil
+		},
+	})
+	registerCommand("god", command{
+		usage: "god",
+		help:  "無敵モードを切り替える（弾に当たってもやられない）",
+		run: func(c *console, args []string) (string, error) {
+			c.game.ToggleGodMode()
+			return "無敵モード " + onOff(c.game.GodMode), nil
+		},
+	})
+	registerCommand("timescale", command{
+		usage: "timescale X",
+		help:  "ゲーム内の時間の流れを X 倍にする（0で停止）",
+		run: func(c *console, args []string) (string, error) {
+			if len(args) != 1 {
+				return "", errUsage
+			}
+			scale, err := strconv.ParseFloat(args[0], 64)
+			if err != nil || scale < 0 {
+				return "", errUsage
+			}
+			c.game.SetTimeScale(scale)
+			if c.game.Frozen {
+				return "時間を停止", nil
+			}
+			return fmt.Sprintf("時間の流れ %.2f 倍", c.game.TimeScale), nil
+		},
+	})
+	registerCommand("step", command{
+		usage: "step [N]",
+		help:  "時間を止めて N フレーム（省略時は1）だけ進める",
+		run: func(c *console, args []string) (string, error) {
+			n := 1
+			if len(args) > 0 {
+				var err error
+				if n, err = intArg(args, 0); err != nil {
+					return "", err
+				}
+			}
+			c.game.StepFrames(n)
+			return fmt.Sprintf("コンソールを閉じると %d フレーム進める", c.game.PendingSteps), nil
+		},
+	})
+	registerCommand("seed", command{
+		usage: "seed [N]",
+		help:  "現在のシードを表示する（N を指定するとそのシードでやり直す）",
+		run: func(c *console, args []string) (string, error) {
+			if len(args) == 0 {
+				return fmt.Sprintf("シード %d", c.game.Seed), nil
+			}
+			seed, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return "", errUsage
+			}
+			restart(c.game, seed)
+			return fmt.Sprintf("シード %d でやり直し", seed), nil
+		},
+	})
+}
+
+// restart は開発用の設定（無敵モードと時間の流れ）を引き継いで、同じ始め方を指定したシードでやり直す
+// シードを変えたプレイはランキング・デイリーチャレンジの記録・実績の対象外になる
+func restart(g *game.Game, seed int64) {
+	god, scale, frozen := g.GodMode, g.TimeScale, g.Frozen
+	run := g.Run
+	run.Seed = seed
+	run.Debugged = true
+	g.StartRun(run)
+	g.GodMode, g.TimeScale, g.Frozen = god, scale, frozen
+}
+
+// intArg は i 番目の引数を整数として読む
+func intArg(args []string, i int) (int, error) {
+	if i >= len(args) {
+		return 0, errUsage
+	}
+	n, err := strconv.Atoi(args[i])
+	if err != nil {
+		return 0, errUsage
+	}
+	return n, nil
+}
+
+// onOff は真偽値を on / off で返す
+func onOff(b bool) string {
+	if b {
+		return "on"
+	}
+	return "off"
+}
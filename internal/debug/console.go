@@ -0,0 +1,199 @@
+//go:build !release
+
+package debug
+
+import (
+	"image/color"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+
+	"game/internal/config"
+	"game/internal/game"
+	"game/internal/text"
+)
+
+// 入力欄と出力の描画方法
+var (
+	consoleStyle = text.Style{Size: 13, Color: color.RGBA{230, 230, 230, 255}}
+	promptStyle  = text.Style{Size: 13, Color: color.RGBA{255, 230, 120, 255}}
+	errorColor   = color.RGBA{255, 120, 120, 255}
+)
+
+// consoleLine はコンソールに表示する出力の1行
+type consoleLine struct {
+	text  string
+	error bool
+}
+
+// console は`キーで画面上部に開くデバッグコンソール
+type console struct {
+	game    *game.Game
+	open    bool
+	input   []rune
+	lines   []consoleLine
+	history []string
+	cursor  int // 履歴をさかのぼっている位置（len(history) なら入力中の行）
+	blink   int
+}
+
+// newConsole はゲームを操作する閉じたコンソールを作成する
+func newConsole(g *game.Game) *console {
+	c := &console{game: g}
+	c.print("help でコマンドの一覧を表示")
+	return c
+}
+
+// toggle はコンソールを開閉する
+func (c *console) toggle() {
+	c.open = !c.open
+	c.input = c.input[:0]
+	c.cursor = len(c.history)
+}
+
+// update は文字の入力と編集、履歴の呼び出し、コマンドの実行を処理する
+func (c *console) update() {
+	c.blink++
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		c.toggle()
+		return
+	}
+
+	// 開閉に使う`は入力しない
+	for _, r := range ebiten.AppendInputChars(nil) {
+		if r != '`' {
+			c.input = append(c.input, r)
+		}
+	}
+	if repeated(ebiten.KeyBackspace) && len(c.input) > 0 {
+		c.input = c.input[:len(c.input)-1]
+	}
+
+	// 上下キーで入力履歴をさかのぼる
+	if inpututil.IsKeyJustPressed(ebiten.KeyUp) && c.cursor > 0 {
+		c.cursor--
+		c.input = []rune(c.history[c.cursor])
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyDown) && c.cursor < len(c.history) {
+		c.cursor++
+		if c.cursor == len(c.history) {
+			c.input = c.input[:0]
+		} else {
+			c.input = []rune(c.history[c.cursor])
+		}
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeyNumpadEnter) {
+		line := strings.TrimSpace(string(c.input))
+		c.input = c.input[:0]
+		if line == "" {
+			return
+		}
+		c.remember(line)
+		c.print("> " + line)
+		c.exec(line)
+	}
+}
+
+// repeated はキーが押された瞬間と、押し続けている間の一定間隔でtrueを返す
+func repeated(key ebiten.Key) bool {
+	d := inpututil.KeyPressDuration(key)
+	return d == 1 || (d > 30 && d%3 == 0)
+}
+
+// remember は入力を履歴に追加する（古いものから捨てる）
+func (c *console) remember(line string) {
+	if n := len(c.history); n == 0 || c.history[n-1] != line {
+		c.history = append(c.history, line)
+	}
+	if len(c.history) > config.DebugConsoleHistory {
+		c.history = c.history[len(c.history)-config.DebugConsoleHistory:]
+	}
+	c.cursor = len(c.history)
+}
+
+// exec は入力された1行をコマンドとして実行し、結果を出力する
+// 先頭の set / give / toggle は読み飛ばす（"set difficulty 5" と "difficulty 5" は同じ）
+func (c *console) exec(line string) {
+	fields := strings.Fields(line)
+	switch fields[0] {
+	case "set", "give", "toggle":
+		fields = fields[1:]
+	}
+	if len(fields) == 0 {
+		c.printError("コマンドを指定してください")
+		return
+	}
+
+	cmd, ok := commands[fields[0]]
+	if !ok {
+		c.printError("不明なコマンド: " + fields[0])
+		return
+	}
+	out, err := cmd.run(c, fields[1:])
+	if err != nil {
+		c.printError(err.Error())
+		c.print("使い方: " + cmd.usage)
+		return
+	}
+	if out != "" {
+		c.print(out)
+	}
+}
+
+// print は出力を1行以上追加する（表示しきれない古い行は捨てる）
+func (c *console) print(s string) {
+	for _, l := range strings.Split(s, "\n") {
+		c.lines = append(c.lines, consoleLine{text: l})
+	}
+	c.trim()
+}
+
+// printError はエラーを出力に追加する
+func (c *console) printError(s string) {
+	c.lines = append(c.lines, consoleLine{text: s, error: true})
+	c.trim()
+}
+
+// trim は表示できる行数を超えた古い出力を捨てる
+func (c *console) trim() {
+	if len(c.lines) > config.DebugConsoleLines {
+		c.lines = c.lines[len(c.lines)-config.DebugConsoleLines:]
+	}
+}
+
+// draw は画面上部にコンソールの出力と入力欄を描く
+func (c *console) draw(screen *ebiten.Image, g *game.Game) {
+	if !c.open {
+		return
+	}
+	scale, _, _ := g.Viewport.Transform()
+	style := consoleStyle
+	style.Scale = scale
+	prompt := promptStyle
+	prompt.Scale = scale
+
+	_, lineHeight := text.Measure("M", style)
+	lineHeight *= 1.3
+	pad := 6 * scale
+	height := lineHeight*float64(config.DebugConsoleLines+1) + pad*2
+	ebitenutil.DrawRect(screen, 0, 0, float64(screen.Bounds().Dx()), height, color.RGBA{0, 0, 0, 200})
+
+	y := pad
+	for _, l := range c.lines {
+		s := style
+		if l.error {
+			s.Color = errorColor
+		}
+		text.Draw(screen, l.text, pad, y, s)
+		y += lineHeight
+	}
+
+	cursor := ""
+	if c.blink/30%2 == 0 {
+		cursor = "_"
+	}
+	text.Draw(screen, "> "+string(c.input)+cursor, pad, height-pad-lineHeight, prompt)
+}
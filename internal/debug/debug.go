@@ -0,0 +1,78 @@
+//go:build !release
+
+// Package debug は開発用ビルドだけで使えるデバッグ表示とデバッグコンソールを提供する
+// リリースビルド（-tags release）では何もしない同じ名前の関数に置き換わる
+package debug
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+
+	"game/internal/game"
+)
+
+// Tools はデバッグ表示とデバッグコンソールをまとめたもの
+type Tools struct {
+	game    *game.Game
+	overlay *overlay
+	console *console
+}
+
+// New はゲームを操作するデバッグ用の道具を作成する
+func New(g *game.Game) *Tools {
+	return &Tools{
+		game:    g,
+		overlay: newOverlay(),
+		console: newConsole(g),
+	}
+}
+
+// Update はF3キーでのデバッグ表示の切り替え、F5〜F8キーでの時間の操作と、`キーで開くコンソールの入力を処理する
+// コンソールが開いている間はキー入力をゲームに渡さないため、trueを返したフレームはゲームを更新しない
+func (t *Tools) Update() bool {
+	if inpututil.IsKeyJustPressed(ebiten.KeyF3) {
+		t.overlay.visible = !t.overlay.visible
+	}
+	t.overlay.sample(t.game)
+
+	if !t.console.open {
+		t.updateTimeKeys()
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyBackquote) {
+		t.console.toggle()
+		return true
+	}
+	if !t.console.open {
+		return false
+	}
+	t.console.update()
+	return true
+}
+
+// updateTimeKeys はゲーム内の時間の流れを操作するキーを処理する
+func (t *Tools) updateTimeKeys() {
+	// F5キーで時間を止める・再び動かす
+	if inpututil.IsKeyJustPressed(ebiten.KeyF5) {
+		t.game.ToggleFreeze()
+	}
+
+	// F6キーで時間を止めて1フレームだけ進める
+	if inpututil.IsKeyJustPressed(ebiten.KeyF6) {
+		t.game.StepFrames(1)
+	}
+
+	// F7キー / F8キーで時間の流れを遅く / 速くする（0.25・0.5・1・2・4倍）
+	if inpututil.IsKeyJustPressed(ebiten.KeyF7) {
+		t.game.SlowerTimeScale()
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyF8) {
+		t.game.FasterTimeScale()
+	}
+}
+
+// Draw はゲームの画面の上にデバッグ表示とコンソールを重ねる
+func (t *Tools) Draw(screen *ebiten.Image) {
+	t.overlay.draw(screen, t.game)
+	t.console.draw(screen, t.game)
+}
@@ -0,0 +1,119 @@
+//go:build !release
+
+package debug
+
+import (
+	"fmt"
+	"image/color"
+	"runtime"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"game/internal/config"
+	"game/internal/game"
+	"game/internal/text"
+)
+
+// 当たり判定の表示色
+var (
+	hitboxPlayer = color.RGBA{0, 255, 0, 255}
+	hitboxGraze  = color.RGBA{0, 255, 0, 90}
+	hitboxBullet = color.RGBA{255, 60, 60, 200}
+	hitboxItem   = color.RGBA{80, 160, 255, 200}
+)
+
+// overlayStyle はデバッグ表示の文字の描画方法
+var overlayStyle = text.Style{Size: 12, Color: color.RGBA{220, 255, 220, 255}, Outline: 1}
+
+// memSample はメモリの統計を取った時点の値
+type memSample struct {
+	tick    int
+	mallocs uint64
+}
+
+// overlay はF3キーで切り替えるデバッグ表示
+// メモリの統計は取得が重いため、一定フレームごとに取り直した値を表示する
+type overlay struct {
+	visible      bool
+	frames       int     // 統計を取り直すまでの経過フレーム数
+	last         memSample
+	allocPerTick float64 // 直近の区間での1フレームあたりのメモリ確保回数
+	heapAlloc    uint64
+	numGC        uint32
+}
+
+// newOverlay は非表示のデバッグ表示を作成する
+func newOverlay() *overlay {
+	return &overlay{}
+}
+
+// sample は表示中なら一定フレームごとにメモリの統計を取り直す
+func (o *overlay) sample(g *game.Game) {
+	if !o.visible {
+		return
+	}
+	o.frames++
+	if o.frames < config.DebugStatsInterval {
+		return
+	}
+	o.frames = 0
+
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	if ticks := g.Tick - o.last.tick; ticks > 0 && o.last.mallocs > 0 {
+		o.allocPerTick = float64(m.Mallocs-o.last.mallocs) / float64(ticks)
+	}
+	o.last = memSample{tick: g.Tick, mallocs: m.Mallocs}
+	o.heapAlloc = m.HeapAlloc
+	o.numGC = m.NumGC
+}
+
+// draw は当たり判定と各種の数値を描く
+func (o *overlay) draw(screen *ebiten.Image, g *game.Game) {
+	if !o.visible {
+		return
+	}
+	scale, _, _ := g.Viewport.Transform()
+	drawHitboxes(screen, g)
+
+	lines := []string{
+		fmt.Sprintf("FPS %.1f  TPS %.1f", ebiten.ActualFPS(), ebiten.ActualTPS()),
+		fmt.Sprintf("tick %d  seed %d", g.Tick, g.Seed),
+		fmt.Sprintf("level %d  bullets %d  items %d", g.Difficulty, len(g.Bullets), len(g.Items)),
+		fmt.Sprintf("collision checks/tick %d", g.CollisionChecks),
+		fmt.Sprintf("allocs/tick %.1f  heap %.1f MiB  GC %d", o.allocPerTick, float64(o.heapAlloc)/(1<<20), o.numGC),
+		fmt.Sprintf("timescale %.2f  frozen %v  god %v", g.TimeScale, g.Frozen, g.GodMode),
+	}
+	str := strings.Join(lines, "\n")
+	style := overlayStyle
+	style.Scale = scale
+	w, h := text.Measure(str, style)
+	pad := 4 * scale
+	ebitenutil.DrawRect(screen, 0, 0, w+pad*2, h+pad*2, color.RGBA{0, 0, 0, 160})
+	text.Draw(screen, str, pad, pad, style)
+}
+
+// drawHitboxes はプレイヤー・弾・アイテムの当たり判定の円を描く
+func drawHitboxes(screen *ebiten.Image, g *game.Game) {
+	scale, offsetX, offsetY := g.Viewport.Transform()
+	playfield := g.Viewport.PlayfieldRect()
+	circle := func(x, y, r float64, clr color.RGBA) {
+		sx := offsetX + (float64(playfield.Min.X)+x)*scale
+		sy := offsetY + (float64(playfield.Min.Y)+y)*scale
+		vector.StrokeCircle(screen, float32(sx), float32(sy), float32(r*scale), 1, clr, false)
+	}
+
+	for _, b := range g.Bullets {
+		circle(b.X, b.Y, b.Size, hitboxBullet)
+	}
+	for _, item := range g.Items {
+		base := item.Base()
+		circle(base.X, base.Y, base.Size, hitboxItem)
+	}
+	p := g.Player
+	circle(p.X, p.Y, p.Size, hitboxPlayer)
+	circle(p.X, p.Y, p.Size+config.Current().Graze.Margin, hitboxGraze)
+}
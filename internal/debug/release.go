@@ -0,0 +1,27 @@
+//go:build release
+
+// Package debug は開発用ビルドだけで使えるデバッグ表示とデバッグコンソールを提供する
+// リリースビルドでは何もしない
+package debug
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"game/internal/game"
+)
+
+// Tools はリリースビルドでは何もしないデバッグ用の道具
+type Tools struct{}
+
+// New は何もしないデバッグ用の道具を作成する
+func New(g *game.Game) *Tools {
+	return &Tools{}
+}
+
+// Update は何もせず、常にゲームを更新させる
+func (t *Tools) Update() bool {
+	return false
+}
+
+// Draw は何も描かない
+func (t *Tools) Draw(screen *ebiten.Image) {}
@@ -0,0 +1,242 @@
+package difficulty
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"game/internal/pattern"
+)
+
+// Keyframe はあるレベルでの値
+type Keyframe struct {
+	Level int     `json:"level"`
+	Value float64 `json:"value"`
+}
+
+// Track はレベルに対する1つの値の変化
+// キーフレームが指定されていればそれを補間し、なければ一次式 base + per_level*(level-1) で求める
+type Track struct {
+	Keyframes []Keyframe `json:"keyframes,omitempty"`
+	Step      bool       `json:"step,omitempty"`      // キーフレーム間を補間せず、次のキーフレームまで値を保つ（段階的な横ばい）
+	Base      float64    `json:"base,omitempty"`      // 一次式: レベル1での値
+	PerLevel  float64    `json:"per_level,omitempty"` // 一次式: レベルが1上がるごとの増分
+	Min       *float64   `json:"min,omitempty"`       // 下限（省略すれば制限なし）
+	Max       *float64   `json:"max,omitempty"`       // 上限（省略すれば制限なし）
+}
+
+// Value は指定したレベルでの値を返す
+func (t Track) Value(level int) float64 {
+	var v float64
+	if len(t.Keyframes) > 0 {
+		v = t.interpolate(level)
+	} else {
+		v = t.Base + t.PerLevel*float64(level-1)
+	}
+	return t.clamp(v)
+}
+
+// clamp は値を上下限の範囲に収める
+func (t Track) clamp(v float64) float64 {
+	if t.Min != nil {
+		v = math.Max(v, *t.Min)
+	}
+	if t.Max != nil {
+		v = math.Min(v, *t.Max)
+	}
+	return v
+}
+
+// lowest はレベル1から maxLevel まで（0なら上限なし）での最小値を返す
+// キーフレームの間は一次式の補間なので、最小値はキーフレームか範囲の端のどちらかになる
+// 上限のない一次式が減り続ける場合は下限（なければ -Inf）を返す
+func (t Track) lowest(maxLevel int) float64 {
+	if len(t.Keyframes) > 0 {
+		v := t.Value(1)
+		for _, k := range t.Keyframes {
+			if maxLevel == 0 || k.Level <= maxLevel {
+				v = math.Min(v, t.Value(k.Level))
+			}
+		}
+		if maxLevel > 0 {
+			v = math.Min(v, t.Value(maxLevel))
+		}
+		return v
+	}
+	switch {
+	case t.PerLevel >= 0:
+		return t.Value(1)
+	case maxLevel > 0:
+		return t.Value(maxLevel)
+	default:
+		return t.clamp(math.Inf(-1))
+	}
+}
+
+// interpolate はキーフレームの間を補間した値を返す（範囲外は端の値で横ばい）
+func (t Track) interpolate(level int) float64 {
+	first := t.Keyframes[0]
+	if level <= first.Level {
+		return first.Value
+	}
+	
+	for i := 1; i < len(t.Keyframes); i++ {
+		prev := t.Keyframes[i-1]
+		next := t.Keyframes[i]
+		if level >= next.Level {
+			continue
+		}
+		if t.Step {
+			return prev.Value
+		}
+		progress := float64(level-prev.Level) / float64(next.Level-prev.Level)
+		return prev.Value + (next.Value-prev.Value)*progress
+	}
+	return t.Keyframes[len(t.Keyframes)-1].Value
+}
+
+// validate はトラックの設定が正しいかどうかを検証する
+func (t Track) validate(name string) error {
+	for i, k := range t.Keyframes {
+		if k.Level < 1 {
+			return fmt.Errorf("%s: keyframes[%d] のlevelは1以上である必要があります（%d）", name, i, k.Level)
+		}
+		if i > 0 && k.Level <= t.Keyframes[i-1].Level {
+			return fmt.Errorf("%s: keyframes はlevelの昇順に並べる必要があります（keyframes[%d] level=%d）", name, i, k.Level)
+		}
+	}
+	if len(t.Keyframes) == 0 && t.Base == 0 && t.PerLevel == 0 {
+		return fmt.Errorf("%s: keyframes か base/per_level のどちらかを指定してください", name)
+	}
+	if t.Min != nil && t.Max != nil && *t.Min > *t.Max {
+		return fmt.Errorf("%s: min (%g) が max (%g) より大きくなっています", name, *t.Min, *t.Max)
+	}
+	return nil
+}
+
+// PatternStage はあるレベル以降に使われる弾幕パターンの候補
+type PatternStage struct {
+	FromLevel int      `json:"from_level"`
+	Pool      []string `json:"pool"`
+}
+
+// Breather は一定レベルごとに挟む小休止の設定
+type Breather struct {
+	Every         int     `json:"every"`          // このレベルの倍数で小休止（0なら小休止なし）
+	BulletsScale  float64 `json:"bullets_scale"`  // 小休止中の発射数の倍率
+	SpeedScale    float64 `json:"speed_scale"`    // 小休止中の弾速の倍率
+	IntervalScale float64 `json:"interval_scale"` // 小休止中の発射間隔の倍率
+}
+
+// Curve は難易度レベルに対する各パラメータの変化
+type Curve struct {
+	MaxLevel        int            `json:"max_level"` // これより上のレベルは上限レベルとして扱う（0なら上限なし）
+	BulletsPerWave  Track          `json:"bullets_per_wave"`
+	SpeedMultiplier Track          `json:"speed_multiplier"`
+	SpawnInterval   Track          `json:"spawn_interval"` // 発射間隔（秒）
+	Patterns        []PatternStage `json:"patterns"`
+	Breather        Breather       `json:"breather"`
+}
+
+// Params はあるレベルでの弾幕のパラメータ
+type Params struct {
+	Level           int
+	BulletsPerWave  int
+	SpeedMultiplier float64
+	SpawnInterval   float64
+	Patterns        []string
+	Breather        bool // 小休止中かどうか
+}
+
+// At は指定したレベルでのパラメータを返す
+func (c *Curve) At(level int) Params {
+	effective := level
+	if c.MaxLevel > 0 && effective > c.MaxLevel {
+		effective = c.MaxLevel
+	}
+	
+	p := Params{
+		Level:           level,
+		SpeedMultiplier: c.SpeedMultiplier.Value(effective),
+		SpawnInterval:   c.SpawnInterval.Value(effective),
+		Patterns:        c.patternsAt(effective),
+	}
+	bullets := c.BulletsPerWave.Value(effective)
+	
+	// 小休止のレベルでは弾幕を緩める
+	if c.Breather.Every > 0 && level%c.Breather.Every == 0 {
+		p.Breather = true
+		bullets *= c.Breather.BulletsScale
+		p.SpeedMultiplier *= c.Breather.SpeedScale
+		p.SpawnInterval *= c.Breather.IntervalScale
+	}
+	
+	p.BulletsPerWave = int(bullets)
+	if p.BulletsPerWave < 1 {
+		p.BulletsPerWave = 1
+	}
+	return p
+}
+
+// patternsAt は指定したレベルで使われる弾幕パターンの候補を返す
+func (c *Curve) patternsAt(level int) []string {
+	pool := []string{"random"}
+	for _, stage := range c.Patterns {
+		if level >= stage.FromLevel {
+			pool = stage.Pool
+		}
+	}
+	return pool
+}
+
+// PickPattern は指定したレベルの候補から rng で弾幕パターンを選ぶ
+func (p Params) PickPattern(rng *rand.Rand) string {
+	return p.Patterns[rng.Intn(len(p.Patterns))]
+}
+
+// Validate はカーブの設定が正しいかどうかを検証する
+func (c *Curve) Validate() error {
+	if c.MaxLevel < 0 {
+		return fmt.Errorf("max_level は0以上である必要があります（%d）", c.MaxLevel)
+	}
+	
+	tracks := []struct {
+		name  string
+		track Track
+	}{
+		{"bullets_per_wave", c.BulletsPerWave},
+		{"speed_multiplier", c.SpeedMultiplier},
+		{"spawn_interval", c.SpawnInterval},
+	}
+	for _, t := range tracks {
+		if err := t.track.validate(t.name); err != nil {
+			return err
+		}
+	}
+	
+	// 発射間隔はすべてのレベルで正である必要がある（上限レベルがなければ最後のキーフレームより先や、減り続ける一次式も調べる）
+	if v := c.SpawnInterval.lowest(c.MaxLevel); v <= 0 {
+		return fmt.Errorf("spawn_interval: 0以下になるレベルがあります（最小値 %g）。min を正の値にするか max_level を設定してください", v)
+	}
+	
+	if !sort.SliceIsSorted(c.Patterns, func(i, j int) bool { return c.Patterns[i].FromLevel < c.Patterns[j].FromLevel }) {
+		return fmt.Errorf("patterns は from_level の昇順に並べる必要があります")
+	}
+	for i, stage := range c.Patterns {
+		if len(stage.Pool) == 0 {
+			return fmt.Errorf("patterns[%d]: pool が空です", i)
+		}
+		for _, name := range stage.Pool {
+			if _, ok := pattern.Get(name); !ok {
+				return fmt.Errorf("patterns[%d]: 未知の弾幕パターン %q（使用可能: %v）", i, name, pattern.Names())
+			}
+		}
+	}
+	
+	if b := c.Breather; b.Every > 0 && (b.BulletsScale <= 0 || b.SpeedScale <= 0 || b.IntervalScale <= 0) {
+		return fmt.Errorf("breather: bullets_scale, speed_scale, interval_scale は正の値である必要があります")
+	}
+	return nil
+}
+
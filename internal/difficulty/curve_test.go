@@ -0,0 +1,163 @@
+package difficulty
+
+import (
+	"strings"
+	"testing"
+)
+
+func ptr(v float64) *float64 { return &v }
+
+func TestTrackValue(t *testing.T) {
+	keyframes := []Keyframe{{Level: 5, Value: 1}, {Level: 10, Value: 2}, {Level: 20, Value: 0.5}}
+	tests := []struct {
+		name  string
+		track Track
+		level int
+		want  float64
+	}{
+		// キーフレームの補間（範囲外は端の値で横ばい）
+		{"最初のキーフレームより前", Track{Keyframes: keyframes}, 1, 1},
+		{"最初のキーフレーム", Track{Keyframes: keyframes}, 5, 1},
+		{"キーフレームの間", Track{Keyframes: keyframes}, 7, 1.4},
+		{"途中のキーフレーム", Track{Keyframes: keyframes}, 10, 2},
+		{"途中のキーフレームの次", Track{Keyframes: keyframes}, 11, 1.85},
+		{"最後のキーフレーム", Track{Keyframes: keyframes}, 20, 0.5},
+		{"最後のキーフレームより後", Track{Keyframes: keyframes}, 99, 0.5},
+
+		// 段階的な横ばい（次のキーフレームのレベルで切り替わる）
+		{"段階: キーフレームの直前", Track{Keyframes: keyframes, Step: true}, 9, 1},
+		{"段階: キーフレーム", Track{Keyframes: keyframes, Step: true}, 10, 2},
+		{"段階: 最後のキーフレームの直前", Track{Keyframes: keyframes, Step: true}, 19, 2},
+		{"段階: 最後のキーフレーム", Track{Keyframes: keyframes, Step: true}, 20, 0.5},
+
+		// 一次式と上下限
+		{"一次式: レベル1", Track{Base: 1, PerLevel: 0.5}, 1, 1},
+		{"一次式: レベル5", Track{Base: 1, PerLevel: 0.5}, 5, 3},
+		{"一次式: 上限に届く前", Track{Base: 1, PerLevel: 1, Max: ptr(8)}, 8, 8},
+		{"一次式: 上限", Track{Base: 1, PerLevel: 1, Max: ptr(8)}, 9, 8},
+		{"一次式: 下限", Track{Base: 1, PerLevel: -0.5, Min: ptr(0.25)}, 10, 0.25},
+		{"下限0", Track{Base: 1, PerLevel: -1, Min: ptr(0)}, 5, 0},
+		{"上限0", Track{Base: -2, PerLevel: 1, Max: ptr(0)}, 5, 0},
+		{"キーフレームの上限", Track{Keyframes: keyframes, Max: ptr(1.5)}, 10, 1.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.track.Value(tt.level); !nearlyEqual(got, tt.want) {
+				t.Errorf("Value(%d) = %g, want %g", tt.level, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCurveAt(t *testing.T) {
+	c := &Curve{
+		MaxLevel:        10,
+		BulletsPerWave:  Track{Base: 2, PerLevel: 1},
+		SpeedMultiplier: Track{Base: 1, PerLevel: 0.1},
+		SpawnInterval:   Track{Base: 0.2},
+		Patterns: []PatternStage{
+			{FromLevel: 1, Pool: []string{"random"}},
+			{FromLevel: 5, Pool: []string{"random", "aimed"}},
+		},
+		Breather: Breather{Every: 4, BulletsScale: 0.5, SpeedScale: 0.5, IntervalScale: 2},
+	}
+
+	tests := []struct {
+		level    int
+		bullets  int
+		speed    float64
+		interval float64
+		patterns int
+		breather bool
+	}{
+		{1, 2, 1, 0.2, 1, false},
+		{4, 2, 0.65, 0.4, 1, true}, // 小休止（発射数5の半分、弾速1.3の半分、間隔2倍）
+		{5, 6, 1.4, 0.2, 2, false},
+		{10, 11, 1.9, 0.2, 2, false},
+		{11, 11, 1.9, 0.2, 2, false}, // 上限レベルより上は上限レベルの値
+		{12, 5, 0.95, 0.4, 2, true}, // 上限レベルより上でも小休止は挟む
+	}
+	for _, tt := range tests {
+		p := c.At(tt.level)
+		if p.Level != tt.level || p.BulletsPerWave != tt.bullets || !nearlyEqual(p.SpeedMultiplier, tt.speed) ||
+			!nearlyEqual(p.SpawnInterval, tt.interval) || len(p.Patterns) != tt.patterns || p.Breather != tt.breather {
+			t.Errorf("At(%d) = %+v", tt.level, p)
+		}
+	}
+}
+
+func TestCurveValidate(t *testing.T) {
+	valid := func() *Curve {
+		return &Curve{
+			BulletsPerWave:  Track{Base: 1, PerLevel: 1},
+			SpeedMultiplier: Track{Base: 1},
+			SpawnInterval:   Track{Base: 0.2},
+		}
+	}
+	tests := []struct {
+		name   string
+		modify func(c *Curve)
+		want   string // エラーに含まれるはずの文（空なら正しいカーブ）
+	}{
+		{"正しいカーブ", func(c *Curve) {}, ""},
+		{"上限レベルが負", func(c *Curve) { c.MaxLevel = -1 }, "max_level"},
+		{"キーフレームのレベルが0", func(c *Curve) { c.SpeedMultiplier = Track{Keyframes: []Keyframe{{Level: 0, Value: 1}}} }, "speed_multiplier"},
+		{"キーフレームが昇順でない", func(c *Curve) {
+			c.SpeedMultiplier = Track{Keyframes: []Keyframe{{Level: 5, Value: 1}, {Level: 5, Value: 2}}}
+		}, "昇順"},
+		{"値の指定がない", func(c *Curve) { c.BulletsPerWave = Track{} }, "bullets_per_wave"},
+		{"下限が上限より大きい", func(c *Curve) { c.BulletsPerWave.Min, c.BulletsPerWave.Max = ptr(2), ptr(1) }, "min"},
+
+		// 発射間隔はすべてのレベルで正である必要がある
+		{"発射間隔が最後のキーフレームで0", func(c *Curve) {
+			c.SpawnInterval = Track{Keyframes: []Keyframe{{Level: 1, Value: 0.2}, {Level: 60, Value: 0}}}
+		}, "spawn_interval"},
+		{"上限レベルより上のキーフレームは使われない", func(c *Curve) {
+			c.MaxLevel = 30
+			c.SpawnInterval = Track{Keyframes: []Keyframe{{Level: 1, Value: 0.2}, {Level: 60, Value: 0}}}
+		}, ""},
+		{"上限レベルのない減り続ける一次式", func(c *Curve) { c.SpawnInterval = Track{Base: 0.2, PerLevel: -0.001} }, "spawn_interval"},
+		{"上限レベルのない減り続ける一次式の下限が0", func(c *Curve) {
+			c.SpawnInterval = Track{Base: 0.2, PerLevel: -0.001, Min: ptr(0)}
+		}, "spawn_interval"},
+		{"上限レベルのない減り続ける一次式に正の下限", func(c *Curve) {
+			c.SpawnInterval = Track{Base: 0.2, PerLevel: -0.001, Min: ptr(0.05)}
+		}, ""},
+		{"上限レベルまでは正の一次式", func(c *Curve) {
+			c.MaxLevel = 100
+			c.SpawnInterval = Track{Base: 0.2, PerLevel: -0.001}
+		}, ""},
+		{"上限レベルで0になる一次式", func(c *Curve) {
+			c.MaxLevel = 201
+			c.SpawnInterval = Track{Base: 0.2, PerLevel: -0.001}
+		}, "spawn_interval"},
+
+		{"弾幕パターンが昇順でない", func(c *Curve) {
+			c.Patterns = []PatternStage{{FromLevel: 5, Pool: []string{"random"}}, {FromLevel: 1, Pool: []string{"random"}}}
+		}, "from_level"},
+		{"弾幕パターンの候補が空", func(c *Curve) { c.Patterns = []PatternStage{{FromLevel: 1}} }, "pool"},
+		{"未知の弾幕パターン", func(c *Curve) { c.Patterns = []PatternStage{{FromLevel: 1, Pool: []string{"spiral?"}}} }, "spiral?"},
+		{"小休止の倍率が0", func(c *Curve) { c.Breather = Breather{Every: 5, BulletsScale: 0.5, SpeedScale: 1} }, "breather"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := valid()
+			tt.modify(c)
+			err := c.Validate()
+			switch {
+			case tt.want == "" && err != nil:
+				t.Errorf("正しいカーブがエラーになりました: %v", err)
+			case tt.want != "" && err == nil:
+				t.Error("エラーになりませんでした")
+			case tt.want != "" && !strings.Contains(err.Error(), tt.want):
+				t.Errorf("エラーに %s が含まれていません: %v", tt.want, err)
+			}
+		})
+	}
+}
+
+// nearlyEqual は浮動小数点の誤差を許して比較する
+func nearlyEqual(a, b float64) bool {
+	const epsilon = 1e-9
+	return a-b < epsilon && b-a < epsilon
+}
@@ -0,0 +1,95 @@
+package difficulty
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"game/internal/config"
+)
+
+// defaultCurvesJSON は組み込みの難易度カーブ
+//
+//go:embed curves.json
+var defaultCurvesJSON []byte
+
+// CurveSet は難易度プリセット名ごとの難易度カーブ
+type CurveSet map[string]*Curve
+
+// active は現在使われている難易度カーブ
+var active CurveSet
+
+func init() {
+	set, err := Parse(defaultCurvesJSON)
+	if err != nil {
+		panic(fmt.Sprintf("組み込みの難易度カーブが不正です: %v", err))
+	}
+	
+	// すべての難易度プリセットにカーブが必要
+	for _, preset := range config.Default().Presets() {
+		if _, ok := set[preset.Name]; !ok {
+			panic(fmt.Sprintf("組み込みの難易度カーブに %s がありません", preset.Name))
+		}
+	}
+	active = set
+}
+
+// Parse はJSONから難易度カーブを読み込み、検証する
+func Parse(data []byte) (CurveSet, error) {
+	var set CurveSet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("難易度カーブのJSONを解析できません: %w", err)
+	}
+	for name, curve := range set {
+		if curve == nil {
+			return nil, fmt.Errorf("難易度カーブ %s: カーブの定義がありません", name)
+		}
+		if err := curve.Validate(); err != nil {
+			return nil, fmt.Errorf("難易度カーブ %s: %w", name, err)
+		}
+	}
+	return set, nil
+}
+
+// LoadFile はファイルから難易度カーブを読み込む
+func LoadFile(path string) (CurveSet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	set, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return set, nil
+}
+
+// Use は読み込んだ難易度カーブを有効にする
+// ファイルに含まれないプリセットは組み込みのカーブのまま残る
+func Use(set CurveSet) error {
+	for name := range set {
+		if !isPreset(name) {
+			return fmt.Errorf("難易度カーブ %s に対応する難易度プリセットがありません", name)
+		}
+	}
+	for name, curve := range set {
+		active[name] = curve
+	}
+	return nil
+}
+
+// For は指定したプリセットの難易度カーブを返す
+func For(preset config.DifficultyKind) *Curve {
+	return active[config.Default().Preset(preset).Name]
+}
+
+// isPreset は名前が難易度プリセットのものかどうかを返す
+func isPreset(name string) bool {
+	for _, preset := range config.Default().Presets() {
+		if preset.Name == name {
+			return true
+		}
+	}
+	return false
+}
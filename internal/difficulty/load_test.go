@@ -0,0 +1,45 @@
+package difficulty
+
+import (
+	"testing"
+
+	"game/internal/config"
+)
+
+func TestBuiltinCurves(t *testing.T) {
+	for i := 0; i < config.DifficultyCount; i++ {
+		if For(config.DifficultyKind(i)) == nil {
+			t.Errorf("難易度 %d の組み込みのカーブがありません", i)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		ok   bool
+	}{
+		{"正しいカーブ", `{"EASY": {"bullets_per_wave": {"base": 1}, "speed_multiplier": {"base": 1}, "spawn_interval": {"base": 0.2}}}`, true},
+		{"カーブがnull", `{"EASY": null}`, false},
+		{"不正なカーブ", `{"EASY": {"bullets_per_wave": {"base": 1}, "speed_multiplier": {"base": 1}, "spawn_interval": {"base": 0}}}`, false},
+		{"JSONでない", `{"EASY": `, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse([]byte(tt.json)); (err == nil) != tt.ok {
+				t.Errorf("Parse() = %v, 正しいカーブかどうか %v", err, tt.ok)
+			}
+		})
+	}
+}
+
+func TestUseRejectsUnknownPreset(t *testing.T) {
+	set, err := Parse([]byte(`{"EXTRA": {"bullets_per_wave": {"base": 1}, "speed_multiplier": {"base": 1}, "spawn_interval": {"base": 0.2}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Use(set); err == nil {
+		t.Error("難易度プリセットにない名前のカーブがエラーになりませんでした")
+	}
+}
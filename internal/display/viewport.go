@@ -0,0 +1,122 @@
+package display
+
+import (
+	"image"
+	"math"
+
+	"game/internal/config"
+)
+
+// Aspect は画面全体（キャンバス）の縦横比の種類
+type Aspect int
+
+// キャンバスの縦横比の種類
+const (
+	Aspect4x3  Aspect = iota // プレイフィールドのみ（4:3）
+	Aspect16x9               // プレイフィールドの左右にHUD用のサイドパネル（16:9）
+)
+
+// AspectCount はキャンバスの縦横比の種類の数
+const AspectCount = 2
+
+// String は縦横比の表示名を返す
+func (a Aspect) String() string {
+	switch a {
+	case Aspect16x9:
+		return "16:9"
+	default:
+		return "4:3"
+	}
+}
+
+// Viewport はウィンドウの大きさとは独立した論理的なプレイフィールドを、
+// ウィンドウに拡大縮小して収めるための情報を管理する
+//
+// 座標系は3つある:
+//   - スクリーン座標: ウィンドウ（Layoutが返すサイズ）のピクセル
+//   - キャンバス座標: プレイフィールドとサイドパネルを並べた論理的な画面
+//   - プレイフィールド座標: ゲームの論理座標（config.ScreenWidth × config.ScreenHeight）
+type Viewport struct {
+	Aspect       Aspect
+	IntegerScale bool // 整数倍でのみ拡大する（ドット感を保つ）
+	
+	screenWidth, screenHeight int // 直近のLayoutで決まったスクリーンのサイズ
+}
+
+// NewViewport は新しいビューポートを作成する
+func NewViewport() *Viewport {
+	return &Viewport{
+		Aspect:       Aspect4x3,
+		IntegerScale: false,
+		screenWidth:  config.ScreenWidth,
+		screenHeight: config.ScreenHeight,
+	}
+}
+
+// Layout はウィンドウの大きさをそのままスクリーンのサイズとして記録して返す
+// deviceScale には高DPI環境でのデバイスのスケール係数を渡す
+func (v *Viewport) Layout(outsideWidth, outsideHeight int, deviceScale float64) (int, int) {
+	v.screenWidth = int(math.Ceil(float64(outsideWidth) * deviceScale))
+	v.screenHeight = int(math.Ceil(float64(outsideHeight) * deviceScale))
+	if v.screenWidth < 1 {
+		v.screenWidth = 1
+	}
+	if v.screenHeight < 1 {
+		v.screenHeight = 1
+	}
+	return v.screenWidth, v.screenHeight
+}
+
+// CanvasSize はキャンバス（プレイフィールドとサイドパネル）のサイズを返す
+func (v *Viewport) CanvasSize() (int, int) {
+	if v.Aspect == Aspect16x9 {
+		return int(math.Round(config.ScreenHeight * 16.0 / 9.0)), config.ScreenHeight
+	}
+	return config.ScreenWidth, config.ScreenHeight
+}
+
+// PlayfieldRect はキャンバス上でのプレイフィールドの範囲を返す
+func (v *Viewport) PlayfieldRect() image.Rectangle {
+	canvasWidth, _ := v.CanvasSize()
+	x := (canvasWidth - config.ScreenWidth) / 2
+	return image.Rect(x, 0, x+config.ScreenWidth, config.ScreenHeight)
+}
+
+// SidePanels はキャンバス上での左右のサイドパネルの範囲を返す（4:3では空）
+func (v *Viewport) SidePanels() (left, right image.Rectangle) {
+	canvasWidth, canvasHeight := v.CanvasSize()
+	playfield := v.PlayfieldRect()
+	left = image.Rect(0, 0, playfield.Min.X, canvasHeight)
+	right = image.Rect(playfield.Max.X, 0, canvasWidth, canvasHeight)
+	return left, right
+}
+
+// Transform はキャンバスをスクリーンに描く際の拡大率と左上の位置を返す（余白はレターボックス）
+func (v *Viewport) Transform() (scale, offsetX, offsetY float64) {
+	canvasWidth, canvasHeight := v.CanvasSize()
+	scale = math.Min(float64(v.screenWidth)/float64(canvasWidth), float64(v.screenHeight)/float64(canvasHeight))
+	
+	// 整数倍にできる大きさなら整数倍に切り捨てる
+	if v.IntegerScale && scale >= 1 {
+		scale = math.Floor(scale)
+	}
+	
+	offsetX = math.Floor((float64(v.screenWidth) - float64(canvasWidth)*scale) / 2)
+	offsetY = math.Floor((float64(v.screenHeight) - float64(canvasHeight)*scale) / 2)
+	return scale, offsetX, offsetY
+}
+
+// ScreenToPlayfield はスクリーン座標（カーソル位置など）をプレイフィールド座標に変換する
+func (v *Viewport) ScreenToPlayfield(x, y int) (float64, float64) {
+	scale, offsetX, offsetY := v.Transform()
+	playfield := v.PlayfieldRect()
+	
+	canvasX := (float64(x) - offsetX) / scale
+	canvasY := (float64(y) - offsetY) / scale
+	return canvasX - float64(playfield.Min.X), canvasY - float64(playfield.Min.Y)
+}
+
+// NextAspect は次の縦横比に切り替える
+func (v *Viewport) NextAspect() {
+	v.Aspect = (v.Aspect + 1) % AspectCount
+}
@@ -1,73 +1,226 @@
 package entity
 
 import (
-	"image/color"
 	"math"
 	"math/rand"
+
+	"game/internal/config"
+)
+
+// BulletKind は弾の振る舞いの種類（弾の色は種類で決まる）
+type BulletKind int
+
+// 弾の振る舞いの種類
+const (
+	BulletPlain     BulletKind = iota // まっすぐ飛ぶ
+	BulletAimed                       // 発射時にプレイヤーを狙う
+	BulletHoming                      // 飛びながらプレイヤーの方へ曲がる
+	BulletSplitting                   // 一定時間後に全方位へ分裂する
 )
 
+// BulletKinds は弾の振る舞いの種類の一覧（凡例の並び順）
+var BulletKinds = []BulletKind{BulletPlain, BulletAimed, BulletHoming, BulletSplitting}
+
+// String は弾の振る舞いの種類の名前を返す（メッセージのキーに使う）
+func (k BulletKind) String() string {
+	switch k {
+	case BulletAimed:
+		return "aimed"
+	case BulletHoming:
+		return "homing"
+	case BulletSplitting:
+		return "splitting"
+	default:
+		return "plain"
+	}
+}
+
+// SpeedTier は弾の速さの段階
+type SpeedTier int
+
+// 弾の速さの段階
+const (
+	SpeedSlow SpeedTier = iota
+	SpeedMedium
+	SpeedFast
+)
+
+// SpeedTiers は弾の速さの段階の一覧（凡例の並び順）
+var SpeedTiers = []SpeedTier{SpeedSlow, SpeedMedium, SpeedFast}
+
+// String は弾の速さの段階の名前を返す（メッセージのキーに使う）
+func (t SpeedTier) String() string {
+	switch t {
+	case SpeedMedium:
+		return "medium"
+	case SpeedFast:
+		return "fast"
+	default:
+		return "slow"
+	}
+}
+
+// Danger は弾の危険度
+type Danger int
+
+// 弾の危険度
+const (
+	DangerLow Danger = iota
+	DangerMedium
+	DangerHigh
+)
+
+// String は弾の危険度の名前を返す（メッセージのキーに使う）
+func (d Danger) String() string {
+	switch d {
+	case DangerMedium:
+		return "medium"
+	case DangerHigh:
+		return "high"
+	default:
+		return "low"
+	}
+}
+
 // Bullet は弾の構造体
 type Bullet struct {
 	X, Y    float64
 	VX, VY  float64
 	Size    float64
-	Color   color.RGBA
+	Kind    BulletKind
+	Age     float64 // 発射されてからの経過フレーム数（時間の伸縮を反映）
+	Grazed  bool    // すでにプレイヤーをかすめたかどうか
 }
 
-// NewRandomBullet は画面の端から発射されるランダムな弾を作成する
-func NewRandomBullet(screenWidth, screenHeight, bulletSize, minSpeed, maxSpeed float64, difficulty int) *Bullet {
+// NewRandomBullet は画面の端から発射されるランダムな弾を作成する（乱数は rng から引く）
+func NewRandomBullet(rng *rand.Rand, screenWidth, screenHeight, bulletSize, minSpeed, maxSpeed, speedMultiplier float64) *Bullet {
 	var x, y float64
 	var vx, vy float64
 	
-	side := rand.Intn(4) // 0: 上, 1: 右, 2: 下, 3: 左
+	side := rng.Intn(4) // 0: 上, 1: 右, 2: 下, 3: 左
 	
 	// 難易度に応じて弾の速度を調整
-	speedMultiplier := 1.0 + float64(difficulty-1)*0.1 // 難易度ごとに10%ずつ速くなる
 	minSpeed = minSpeed * speedMultiplier
 	maxSpeed = maxSpeed * speedMultiplier
 	
 	switch side {
 	case 0: // 上から
-		x = rand.Float64() * screenWidth
+		x = rng.Float64() * screenWidth
 		y = -bulletSize
-		vx = (rand.Float64()*2 - 1) * maxSpeed
-		vy = rand.Float64()*(maxSpeed-minSpeed) + minSpeed
+		vx = (rng.Float64()*2 - 1) * maxSpeed
+		vy = rng.Float64()*(maxSpeed-minSpeed) + minSpeed
 	case 1: // 右から
 		x = screenWidth + bulletSize
-		y = rand.Float64() * screenHeight
-		vx = -(rand.Float64()*(maxSpeed-minSpeed) + minSpeed)
-		vy = (rand.Float64()*2 - 1) * maxSpeed
+		y = rng.Float64() * screenHeight
+		vx = -(rng.Float64()*(maxSpeed-minSpeed) + minSpeed)
+		vy = (rng.Float64()*2 - 1) * maxSpeed
 	case 2: // 下から
-		x = rand.Float64() * screenWidth
+		x = rng.Float64() * screenWidth
 		y = screenHeight + bulletSize
-		vx = (rand.Float64()*2 - 1) * maxSpeed
-		vy = -(rand.Float64()*(maxSpeed-minSpeed) + minSpeed)
+		vx = (rng.Float64()*2 - 1) * maxSpeed
+		vy = -(rng.Float64()*(maxSpeed-minSpeed) + minSpeed)
 	case 3: // 左から
 		x = -bulletSize
-		y = rand.Float64() * screenHeight
-		vx = rand.Float64()*(maxSpeed-minSpeed) + minSpeed
-		vy = (rand.Float64()*2 - 1) * maxSpeed
+		y = rng.Float64() * screenHeight
+		vx = rng.Float64()*(maxSpeed-minSpeed) + minSpeed
+		vy = (rng.Float64()*2 - 1) * maxSpeed
 	}
 	
-	// ランダムな色を生成
-	r := uint8(rand.Intn(200) + 55)
-	g := uint8(rand.Intn(200) + 55)
-	b := uint8(rand.Intn(200) + 55)
-	
+	return NewBullet(x, y, vx, vy, bulletSize)
+}
+
+// NewBullet は指定した位置と速度でまっすぐ飛ぶ弾を作成する
+func NewBullet(x, y, vx, vy, size float64) *Bullet {
+	return NewKindBullet(BulletPlain, x, y, vx, vy, size)
+}
+
+// NewKindBullet は指定した振る舞いの種類の弾を作成する
+func NewKindBullet(kind BulletKind, x, y, vx, vy, size float64) *Bullet {
 	return &Bullet{
 		X:    x,
 		Y:    y,
 		VX:   vx,
 		VY:   vy,
-		Size: bulletSize,
-		Color: color.RGBA{r, g, b, 255},
+		Size: size,
+		Kind: kind,
 	}
 }
 
-// Update は弾の位置を更新する
-func (b *Bullet) Update() {
-	b.X += b.VX
-	b.Y += b.VY
+// Update は弾の位置を更新する（timeScaleで移動量を伸縮する）
+func (b *Bullet) Update(timeScale float64) {
+	b.X += b.VX * timeScale
+	b.Y += b.VY * timeScale
+	b.Age += timeScale
+}
+
+// Steer は誘導弾の進行方向を (targetX, targetY) へ少しずつ曲げる
+// 誘導するのは発射から一定時間だけで、その後はまっすぐ飛んで画面外へ抜ける
+func (b *Bullet) Steer(targetX, targetY, timeScale float64) {
+	if b.Kind != BulletHoming || b.Age > config.HomingDuration {
+		return
+	}
+	
+	current := math.Atan2(b.VY, b.VX)
+	diff := math.Atan2(targetY-b.Y, targetX-b.X) - current
+	diff = math.Remainder(diff, 2*math.Pi)
+	
+	limit := config.HomingTurnRate * timeScale
+	turn := math.Max(-limit, math.Min(diff, limit))
+	speed := math.Hypot(b.VX, b.VY)
+	b.VX = math.Cos(current+turn) * speed
+	b.VY = math.Sin(current+turn) * speed
+}
+
+// ShouldSplit は分裂弾が分裂する時間になったかどうかを返す
+func (b *Bullet) ShouldSplit() bool {
+	return b.Kind == BulletSplitting && b.Age >= config.SplitDelay
+}
+
+// Split は分裂弾を全方位に飛ぶ小さな弾に分ける（分かれた弾はそれ以上分裂しない）
+func (b *Bullet) Split() []*Bullet {
+	speed := math.Hypot(b.VX, b.VY)
+	offset := math.Atan2(b.VY, b.VX)
+	
+	bullets := make([]*Bullet, 0, config.SplitCount)
+	for i := 0; i < config.SplitCount; i++ {
+		angle := offset + float64(i)*2*math.Pi/config.SplitCount
+		bullets = append(bullets, NewBullet(b.X, b.Y, math.Cos(angle)*speed, math.Sin(angle)*speed, b.Size*config.SplitSizeScale))
+	}
+	return bullets
+}
+
+// SpeedTier は弾の現在の速さの段階を返す
+func (b *Bullet) SpeedTier() SpeedTier {
+	speed := math.Hypot(b.VX, b.VY)
+	switch {
+	case speed >= config.BulletSpeedFast:
+		return SpeedFast
+	case speed >= config.BulletSpeedMedium:
+		return SpeedMedium
+	default:
+		return SpeedSlow
+	}
+}
+
+// Danger は弾の危険度を返す
+// 振る舞いの避けにくさ（狙い・分裂は1、誘導は2）と速さの段階を足し合わせて決める
+func (b *Bullet) Danger() Danger {
+	score := int(b.SpeedTier())
+	switch b.Kind {
+	case BulletAimed, BulletSplitting:
+		score++
+	case BulletHoming:
+		score += 2
+	}
+	
+	switch {
+	case score >= 3:
+		return DangerHigh
+	case score >= 2:
+		return DangerMedium
+	default:
+		return DangerLow
+	}
 }
 
 // IsOutOfScreen は弾が画面外に出たかどうかを判定する
@@ -82,3 +235,11 @@ func (b *Bullet) CollidesWith(x, y, size float64) bool {
 	distance := math.Sqrt(dx*dx + dy*dy)
 	return distance < b.Size+size
 }
+
+// Grazes は弾が指定された円の周囲（margin以内）をかすめているかどうかを判定する
+func (b *Bullet) Grazes(x, y, size, margin float64) bool {
+	dx := b.X - x
+	dy := b.Y - y
+	distance := math.Sqrt(dx*dx + dy*dy)
+	return distance < b.Size+size+margin
+}
@@ -3,12 +3,15 @@ package entity
 import (
 	"image/color"
 	"math"
+
+	"game/internal/config"
 )
 
 // Explosion は爆発エフェクトの構造体
 type Explosion struct {
+	Kind      config.BombKind
 	X, Y      float64
-	Radius    float64
+	Radius    float64 // 円形: 現在の半径 / 掃射: 掃射線のY座標 / 時間停止: 波紋の半径
 	MaxRadius float64
 	Alpha     float64
 	Duration  float64
@@ -17,15 +20,17 @@ type Explosion struct {
 }
 
 // NewExplosion は新しい爆発エフェクトを作成する
-func NewExplosion(x, y, radius float64) *Explosion {
+// 掃射ボムの場合、radiusには掃射する距離（画面の高さ）を渡す
+func NewExplosion(kind config.BombKind, x, y, radius, duration float64) *Explosion {
 	return &Explosion{
+		Kind:      kind,
 		X:         x,
 		Y:         y,
 		Radius:    0,
 		MaxRadius: radius,
 		Alpha:     1.0,
-		Duration:  0.5, // 爆発の持続時間（秒）
-		Lifetime:  0.5,
+		Duration:  duration, // 爆発の持続時間（秒）
+		Lifetime:  duration,
 		Active:    true,
 	}
 }
@@ -38,9 +43,18 @@ func (e *Explosion) Update(deltaTime float64) {
 	
 	e.Lifetime -= deltaTime
 	
-	// 爆発の半径を拡大
-	progress := 1.0 - (e.Lifetime / e.Duration)
-	e.Radius = e.MaxRadius * math.Sin(progress * math.Pi)
+	progress := e.Progress()
+	switch e.Kind {
+	case config.BombLineSweep:
+		// 掃射線を一定速度で下へ進める
+		e.Radius = e.MaxRadius * progress
+	case config.BombTimeStop:
+		// 波紋を素早く広げ、その後は維持する
+		e.Radius = e.MaxRadius * math.Min(1.0, progress*4)
+	default:
+		// 爆発の半径を拡大
+		e.Radius = e.MaxRadius * math.Sin(progress * math.Pi)
+	}
 	
 	// 透明度を徐々に下げる
 	e.Alpha = 1.0 - progress
@@ -50,6 +64,37 @@ func (e *Explosion) Update(deltaTime float64) {
 	}
 }
 
+// Progress は爆発の進行度（0〜1）を返す
+func (e *Explosion) Progress() float64 {
+	return math.Max(0, math.Min(1, 1.0-(e.Lifetime/e.Duration)))
+}
+
+// Contains は指定された円が現在の爆発範囲に含まれるかどうかを判定する
+func (e *Explosion) Contains(x, y, size float64) bool {
+	if !e.Active {
+		return false
+	}
+	
+	switch e.Kind {
+	case config.BombLineSweep:
+		// 掃射線が通過した領域はすべて対象
+		return y-size < e.Radius
+	case config.BombTimeStop:
+		// 時間停止は弾を消さない
+		return false
+	default:
+		dx := x - e.X
+		dy := y - e.Y
+		r := e.Radius + size
+		return dx*dx+dy*dy < r*r
+	}
+}
+
+// StopsTime は弾の動きを止める爆発かどうかを返す
+func (e *Explosion) StopsTime() bool {
+	return e.Active && e.Kind == config.BombTimeStop
+}
+
 // GetColor は爆発の色を取得する
 func (e *Explosion) GetColor() color.RGBA {
 	// 爆発の進行に応じて色を変化させる
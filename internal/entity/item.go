@@ -0,0 +1,182 @@
+package entity
+
+import (
+	"math"
+
+	"game/internal/config"
+)
+
+// ItemKind はアイテムの種類
+type ItemKind int
+
+// アイテムの種類
+const (
+	ItemShield     ItemKind = iota // シールド
+	ItemBombRefill                 // ボムのクールダウン即時回復
+	ItemSlowMotion                 // 弾の動きを遅くする
+	ItemMagnet                     // 周囲のアイテムを引き寄せる
+	ItemScoreGem                   // 得点
+	ItemShrink                     // プレイヤーの当たり判定を縮める
+	ItemInvincible                 // 一定時間無敵
+)
+
+// Item は画面上に出現するアイテムのインターフェース
+type Item interface {
+	Kind() ItemKind
+	Base() *ItemBase
+	Update(deltaTime float64)
+	IsActive() bool
+	CollidesWith(x, y, size float64) bool
+	Deactivate()
+}
+
+// ItemBase はアイテム共通の状態と振る舞い
+type ItemBase struct {
+	X, Y        float64
+	Size        float64
+	Active      bool
+	Lifetime    float64  // 消滅までの残り時間
+	BlinkTime   float64  // 消滅前に点滅を始める残り時間
+	Angle       float64  // 回転角度
+	GlowSize    float64  // 輝きのサイズ
+	GlowDir     float64  // 輝きの方向（拡大/縮小）
+}
+
+// newItemBase は指定位置にアイテム共通の状態を作成する
+func newItemBase(x, y, size, lifetime, blinkTime float64) ItemBase {
+	return ItemBase{
+		X:         x,
+		Y:         y,
+		Size:      size,
+		Active:    true,
+		Lifetime:  lifetime,
+		BlinkTime: blinkTime,
+		Angle:     0,
+		GlowSize:  0,
+		GlowDir:   0.1,
+	}
+}
+
+// Base はアイテム共通の状態を返す
+func (b *ItemBase) Base() *ItemBase {
+	return b
+}
+
+// Update はアイテムのアニメーションと寿命を deltaTime 秒分更新する
+func (b *ItemBase) Update(deltaTime float64) {
+	if !b.Active {
+		return
+	}
+	frames := deltaTime / config.DeltaTime
+	
+	// 回転させる
+	b.Angle += 0.05 * frames
+	
+	// 輝きのサイズを変化させる
+	b.GlowSize += b.GlowDir * frames
+	if b.GlowSize > 3 || b.GlowSize < 0 {
+		b.GlowDir *= -1
+	}
+	
+	// 寿命が尽きたら消滅
+	b.Lifetime -= deltaTime
+	if b.Lifetime <= 0 {
+		b.Deactivate()
+	}
+}
+
+// IsActive はアイテムが画面上に存在するかどうかを返す
+func (b *ItemBase) IsActive() bool {
+	return b.Active
+}
+
+// IsBlinking は消滅間近で点滅中かどうかを返す
+func (b *ItemBase) IsBlinking() bool {
+	return b.Active && b.Lifetime < b.BlinkTime
+}
+
+// Visible は点滅中の表示フェーズかどうかを返す（残り時間が短いほど速く点滅する）
+func (b *ItemBase) Visible() bool {
+	if !b.IsBlinking() {
+		return true
+	}
+	rate := 4.0 + (b.BlinkTime-b.Lifetime)*4.0
+	return math.Mod(b.Lifetime*rate, 1.0) > 0.4
+}
+
+// MoveToward はアイテムを指定座標へ近づける
+func (b *ItemBase) MoveToward(x, y, speed float64) {
+	dx := x - b.X
+	dy := y - b.Y
+	distance := math.Sqrt(dx*dx + dy*dy)
+	if distance <= speed {
+		b.X = x
+		b.Y = y
+		return
+	}
+	b.X += dx / distance * speed
+	b.Y += dy / distance * speed
+}
+
+// CollidesWith はアイテムが指定された座標と衝突するかどうかを判定する
+func (b *ItemBase) CollidesWith(x, y, size float64) bool {
+	if !b.Active {
+		return false
+	}
+	
+	dx := b.X - x
+	dy := b.Y - y
+	distance := dx*dx + dy*dy
+	return distance < (b.Size+size)*(b.Size+size)
+}
+
+// Deactivate はアイテムを非アクティブにする
+func (b *ItemBase) Deactivate() {
+	b.Active = false
+}
+
+// PowerUpItem は取得すると一定時間効果が続くアイテムの構造体
+type PowerUpItem struct {
+	ItemBase
+	kind     ItemKind
+	Duration float64 // 効果の持続時間（秒）
+}
+
+// NewPowerUpItem は新しいパワーアップアイテムを作成する
+func NewPowerUpItem(kind ItemKind, x, y, size, lifetime, blinkTime, duration float64) *PowerUpItem {
+	return &PowerUpItem{
+		ItemBase: newItemBase(x, y, size, lifetime, blinkTime),
+		kind:     kind,
+		Duration: duration,
+	}
+}
+
+// Kind はアイテムの種類を返す
+func (p *PowerUpItem) Kind() ItemKind {
+	return p.kind
+}
+
+// ScoreGemItem は取得すると得点になる宝石アイテムの構造体
+type ScoreGemItem struct {
+	ItemBase
+	Value int
+}
+
+// NewScoreGemItem は新しい宝石アイテムを作成する
+func NewScoreGemItem(x, y, size, lifetime, blinkTime float64, value int) *ScoreGemItem {
+	return &ScoreGemItem{
+		ItemBase: newItemBase(x, y, size, lifetime, blinkTime),
+		Value:    value,
+	}
+}
+
+// Kind はアイテムの種類を返す
+func (s *ScoreGemItem) Kind() ItemKind {
+	return ItemScoreGem
+}
+
+// Update は宝石アイテムを更新する（通常より速く回転する）
+func (s *ScoreGemItem) Update(deltaTime float64) {
+	s.ItemBase.Update(deltaTime)
+	s.Angle += 0.05 * deltaTime / config.DeltaTime
+}
@@ -1,32 +1,81 @@
 package entity
 
+import (
+	"math"
+
+	"game/internal/config"
+)
+
 // Player はプレイヤーの構造体
 type Player struct {
-	X, Y   float64
-	Size   float64
-	Shield int // シールドの耐久値
+	X, Y     float64
+	Size     float64 // 現在の当たり判定のサイズ
+	BaseSize float64 // 縮小していない時のサイズ
+	Shield   int     // シールドの耐久値
+	
+	// アイテム効果の残り時間
+	InvincibleTime float64 // 無敵
+	ShrinkTime     float64 // 縮小
+	MagnetTime     float64 // マグネット
 	
 	// 爆発スキル関連
 	BombAvailable    bool    // 爆発スキルが使用可能かどうか
 	BombCooldown     float64 // クールダウン残り時間
 	BombCooldownMax  float64 // クールダウン最大時間
 	BombRadius       float64 // 爆発の半径
+	BombKind         config.BombKind // 選択中のボムの種類
+	BombCooldownScale float64        // 難易度によるクールダウンの倍率
+	
+	// スロースキル関連
+	SlowSkillAvailable   bool    // スロースキルが使用可能かどうか
+	SlowSkillCooldown    float64 // クールダウン残り時間
+	SlowSkillCooldownMax float64 // クールダウン最大時間
 }
 
 // NewPlayer は新しいプレイヤーを作成する
 func NewPlayer(x, y, size float64) *Player {
-	return &Player{
+	p := &Player{
 		X:               x,
 		Y:               y,
 		Size:            size,
+		BaseSize:        size,
 		Shield:          0, // 初期状態ではシールドなし
 		BombAvailable:   true,
 		BombCooldown:    0,
-		BombCooldownMax: 10.0, // 10秒のクールダウン
-		BombRadius:      150.0, // 爆発の半径
+		BombCooldownScale: 1.0,
+		SlowSkillAvailable:   true,
+		SlowSkillCooldown:    0,
+		SlowSkillCooldownMax: config.Current().SlowMotion.SkillCooldown,
+	}
+	p.SetBombKind(config.DefaultBombKind)
+	return p
+}
+
+// SetBombKind はボムの種類を切り替え、クールダウンと半径を設定に合わせる
+func (p *Player) SetBombKind(kind config.BombKind) {
+	variant := config.Current().Bomb(kind)
+	prevMax := p.BombCooldownMax
+	p.BombKind = kind
+	p.BombCooldownMax = variant.Cooldown * p.BombCooldownScale
+	p.BombRadius = variant.Radius
+	
+	// クールダウン中なら残り時間の割合を保つ（切り替えで待ち時間を短縮できないようにする）
+	if p.BombCooldown > 0 && prevMax > 0 {
+		p.BombCooldown = p.BombCooldown / prevMax * p.BombCooldownMax
 	}
 }
 
+// SetBombCooldownScale は難易度によるボムのクールダウンの倍率を設定する
+func (p *Player) SetBombCooldownScale(scale float64) {
+	p.BombCooldownScale = scale
+	p.SetBombKind(p.BombKind)
+}
+
+// NextBombKind は次の種類のボムに切り替える
+func (p *Player) NextBombKind() {
+	p.SetBombKind((p.BombKind + 1) % config.BombKindCount)
+}
+
 // AddShield はプレイヤーにシールドを追加する
 func (p *Player) AddShield(durability int) {
 	p.Shield = durability
@@ -64,3 +113,77 @@ func (p *Player) UpdateBombCooldown(deltaTime float64) {
 		}
 	}
 }
+
+// UseSlowSkill はスロースキルを使用する
+func (p *Player) UseSlowSkill() bool {
+	if p.SlowSkillAvailable {
+		p.SlowSkillAvailable = false
+		p.SlowSkillCooldown = p.SlowSkillCooldownMax
+		return true
+	}
+	return false
+}
+
+// UpdateSlowSkillCooldown はスロースキルのクールダウンを更新する
+func (p *Player) UpdateSlowSkillCooldown(deltaTime float64) {
+	if !p.SlowSkillAvailable {
+		p.SlowSkillCooldown -= deltaTime
+		if p.SlowSkillCooldown <= 0 {
+			p.SlowSkillAvailable = true
+			p.SlowSkillCooldown = 0
+		}
+	}
+}
+
+// RefillBomb はボムのクールダウンを即座に終わらせる
+func (p *Player) RefillBomb() {
+	p.BombAvailable = true
+	p.BombCooldown = 0
+}
+
+// IsInvincible は無敵状態かどうかを返す
+func (p *Player) IsInvincible() bool {
+	return p.InvincibleTime > 0
+}
+
+// HasMagnet はマグネット効果中かどうかを返す
+func (p *Player) HasMagnet() bool {
+	return p.MagnetTime > 0
+}
+
+// Shrink は一定時間プレイヤーを縮小する
+func (p *Player) Shrink(duration, scale float64) {
+	p.ShrinkTime = duration
+	p.Size = p.BaseSize * scale
+}
+
+// Resize は基本サイズを変更する（縮小中なら縮小後のサイズに合わせる）
+func (p *Player) Resize(baseSize, shrinkScale float64) {
+	p.BaseSize = baseSize
+	p.Size = baseSize
+	if p.ShrinkTime > 0 {
+		p.Size = baseSize * shrinkScale
+	}
+}
+
+// SetSlowSkillCooldownMax はスロースキルのクールダウン最大時間を変更する
+func (p *Player) SetSlowSkillCooldownMax(cooldown float64) {
+	p.SlowSkillCooldownMax = cooldown
+	if p.SlowSkillCooldown > cooldown {
+		p.SlowSkillCooldown = cooldown
+	}
+}
+
+// UpdateEffects はアイテム効果の残り時間を更新する
+func (p *Player) UpdateEffects(deltaTime float64) {
+	p.InvincibleTime = math.Max(0, p.InvincibleTime-deltaTime)
+	p.MagnetTime = math.Max(0, p.MagnetTime-deltaTime)
+	
+	if p.ShrinkTime > 0 {
+		p.ShrinkTime -= deltaTime
+		if p.ShrinkTime <= 0 {
+			p.ShrinkTime = 0
+			p.Size = p.BaseSize
+		}
+	}
+}
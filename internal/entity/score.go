@@ -1,5 +1,7 @@
 package entity
 
+import "game/internal/config"
+
 // ScoreAnimation はスコアアニメーションの構造体
 type ScoreAnimation struct {
 	Score     float64
@@ -21,11 +23,12 @@ func NewScoreAnimation(score, x, y float64) *ScoreAnimation {
 	}
 }
 
-// Update はスコアアニメーションを更新する
+// Update はスコアアニメーションを deltaTime 秒分更新する
 func (s *ScoreAnimation) Update(deltaTime float64) {
+	frames := deltaTime / config.DeltaTime
 	s.Lifetime -= deltaTime
-	s.Scale += 0.02
-	s.Alpha -= 0.02
+	s.Scale += 0.02 * frames
+	s.Alpha -= 0.02 * frames
 }
 
 // IsActive はアニメーションがまだアクティブかどうかを返す
@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"math"
+)
+
+// ScoreItem はボムで消した弾から生まれる得点アイテムの構造体
+type ScoreItem struct {
+	X, Y     float64
+	VX, VY   float64
+	Size     float64
+	Value    int
+	Speed    float64 // 現在の移動速度
+	MaxSpeed float64 // 最高速度
+}
+
+// NewScoreItem は新しい得点アイテムを作成する
+func NewScoreItem(x, y, size float64, value int, maxSpeed float64) *ScoreItem {
+	return &ScoreItem{
+		X:        x,
+		Y:        y,
+		Size:     size,
+		Value:    value,
+		Speed:    1.0,
+		MaxSpeed: maxSpeed,
+	}
+}
+
+// Update は得点アイテムを目標（プレイヤー）に向かって移動させる（timeScaleで移動量と加速を伸縮する）
+func (s *ScoreItem) Update(targetX, targetY, timeScale float64) {
+	dx := targetX - s.X
+	dy := targetY - s.Y
+	distance := math.Sqrt(dx*dx + dy*dy)
+	
+	// 徐々に加速しながらプレイヤーへ吸い寄せられる
+	s.Speed = math.Min(s.Speed*math.Pow(1.1, timeScale), s.MaxSpeed)
+	step := s.Speed * timeScale
+	if distance > 0 {
+		s.VX = dx / distance * s.Speed
+		s.VY = dy / distance * s.Speed
+	}
+	
+	// 目標を通り過ぎないようにする
+	if distance <= step {
+		s.X = targetX
+		s.Y = targetY
+		return
+	}
+	
+	s.X += s.VX * timeScale
+	s.Y += s.VY * timeScale
+}
+
+// CollidesWith は得点アイテムが指定された座標と衝突するかどうかを判定する
+func (s *ScoreItem) CollidesWith(x, y, size float64) bool {
+	dx := s.X - x
+	dy := s.Y - y
+	return dx*dx+dy*dy < (s.Size+size)*(s.Size+size)
+}
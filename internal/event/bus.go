@@ -0,0 +1,39 @@
+package event
+
+import "reflect"
+
+// Bus は出来事を購読者に配信する
+// 配信は Emit の呼び出しの中で同期的に行い、購読した順に呼び出す
+type Bus struct {
+	handlers map[reflect.Type][]func(Event)
+	all      []func(Event)
+}
+
+// NewBus は購読者のいない Bus を作成する
+func NewBus() *Bus {
+	return &Bus{handlers: make(map[reflect.Type][]func(Event))}
+}
+
+// Subscribe は種類 E の出来事の購読者を登録する
+func Subscribe[E Event](b *Bus, handler func(E)) {
+	t := reflect.TypeFor[E]()
+	b.handlers[t] = append(b.handlers[t], func(e Event) {
+		handler(e.(E))
+	})
+}
+
+// SubscribeAll はすべての出来事の購読者を登録する
+// 種類ごとの購読者より後に呼び出すので、他の購読者が更新した状態を参照できる
+func (b *Bus) SubscribeAll(handler func(Event)) {
+	b.all = append(b.all, handler)
+}
+
+// Emit は出来事を購読者に配信する
+func (b *Bus) Emit(e Event) {
+	for _, h := range b.handlers[reflect.TypeOf(e)] {
+		h(e)
+	}
+	for _, h := range b.all {
+		h(e)
+	}
+}
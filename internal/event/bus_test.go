@@ -0,0 +1,34 @@
+package event
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEmit(t *testing.T) {
+	bus := NewBus()
+	var calls []string
+	Subscribe(bus, func(e Grazed) { calls = append(calls, "grazed1") })
+	bus.SubscribeAll(func(e Event) { calls = append(calls, "all:"+e.Name()) })
+	Subscribe(bus, func(e Grazed) { calls = append(calls, "grazed2") })
+	Subscribe(bus, func(e BombUsed) { calls = append(calls, "bomb") })
+
+	bus.Emit(Grazed{Total: 1})
+	bus.Emit(DifficultyUp{Level: 2})
+
+	// 種類ごとの購読者を購読した順に呼び、すべての出来事の購読者はその後に呼ぶ
+	want := []string{"grazed1", "grazed2", "all:Grazed", "all:DifficultyUp"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestSubscribeReceivesEvent(t *testing.T) {
+	bus := NewBus()
+	var got PlayerDied
+	Subscribe(bus, func(e PlayerDied) { got = e })
+	bus.Emit(PlayerDied{Time: 12.5, Score: 300})
+	if got.Time != 12.5 || got.Score != 300 {
+		t.Errorf("受け取った出来事 = %+v", got)
+	}
+}
@@ -0,0 +1,79 @@
+package event
+
+import (
+	"game/internal/config"
+	"game/internal/entity"
+)
+
+// Event はゲーム内で起きた出来事（Bus で配信する）
+type Event interface {
+	// Name は出来事の種類の名前を返す（ログなどに使う）
+	Name() string
+}
+
+// BulletCleared はボムで弾を消したこと（1フレームで消した弾をまとめて配信する）
+type BulletCleared struct {
+	Bullets []*entity.Bullet
+}
+
+// DifficultyUp は難易度レベルが上がったこと
+type DifficultyUp struct {
+	Level int // 上がった後のレベル
+}
+
+// ShieldPicked はシールドアイテムを取ったこと
+type ShieldPicked struct {
+	X, Y       float64
+	Durability int // 取った後のシールドの耐久値
+}
+
+// ItemPicked はアイテムを取ったこと（シールドを含むすべての種類で配信する）
+type ItemPicked struct {
+	Kind entity.ItemKind
+	X, Y float64 // 取ったアイテムの位置
+}
+
+// ShieldHit はシールドが弾を防いだこと
+type ShieldHit struct {
+	X, Y      float64 // 防いだ弾の位置
+	Remaining int     // 残りのシールドの耐久値
+}
+
+// BombUsed はボムを使ったこと
+type BombUsed struct {
+	Kind config.BombKind
+	X, Y float64 // 使ったときのプレイヤーの位置
+}
+
+// PlayerDied はプレイヤーがやられたこと
+type PlayerDied struct {
+	X, Y       float64
+	Time       float64 // 生存時間（秒）
+	Difficulty int
+	Score      int
+	Grazes     int
+	Assist     bool // アシストモードのプレイかどうか
+	Practice   bool // 練習モードのプレイかどうか
+}
+
+// PlayerMissed は残機無制限のプレイでシールドなしに被弾したこと（ゲームオーバーにはならない）
+type PlayerMissed struct {
+	X, Y   float64
+	Misses int // このプレイでの被弾の回数
+}
+
+// Grazed は弾がプレイヤーをかすめたこと
+type Grazed struct {
+	X, Y  float64 // かすめた弾の位置
+	Total int     // このプレイでのグレイズの回数
+}
+
+func (BulletCleared) Name() string { return "BulletCleared" }
+func (DifficultyUp) Name() string  { return "DifficultyUp" }
+func (ShieldPicked) Name() string  { return "ShieldPicked" }
+func (ItemPicked) Name() string    { return "ItemPicked" }
+func (ShieldHit) Name() string     { return "ShieldHit" }
+func (BombUsed) Name() string      { return "BombUsed" }
+func (PlayerDied) Name() string    { return "PlayerDied" }
+func (PlayerMissed) Name() string  { return "PlayerMissed" }
+func (Grazed) Name() string        { return "Grazed" }
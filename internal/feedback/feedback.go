@@ -0,0 +1,96 @@
+package feedback
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+
+	"game/internal/config"
+)
+
+// Feedback は画面の揺れ、ヒットストップ、フラッシュによる手応えの演出を管理する
+// 揺れとフラッシュはアクセシビリティのために設定で無効にできる
+type Feedback struct {
+	ShakeEnabled bool // 画面の揺れを有効にするかどうか
+	FlashEnabled bool // 画面のフラッシュを有効にするかどうか
+	
+	trauma         float64 // 揺れの強さ（0〜1、時間とともに減衰する）
+	offsetX        float64 // 現在の揺れによる画面のずれ
+	offsetY        float64
+	hitStop        int     // 残りのヒットストップのフレーム数
+	flashColor     color.NRGBA // アルファ乗算前の色（不透明度だけを減らして消していく）
+	flashTime      float64 // フラッシュの残り時間
+	flashDuration  float64
+}
+
+// New は揺れとフラッシュが有効な演出を作成する
+func New() *Feedback {
+	return &Feedback{
+		ShakeEnabled: true,
+		FlashEnabled: true,
+	}
+}
+
+// Shake は画面を揺らす（amount は0〜1、重ねると強くなる）
+func (f *Feedback) Shake(amount float64) {
+	f.trauma = math.Min(1, f.trauma+amount)
+}
+
+// HitStop は指定したフレーム数だけゲームの進行を止める（すでに止まっていれば長い方を採用する）
+func (f *Feedback) HitStop(ticks int) {
+	if ticks > f.hitStop {
+		f.hitStop = ticks
+	}
+}
+
+// Flash は画面全体を指定した色（アルファ乗算前）で光らせ、duration 秒かけて消す
+func (f *Feedback) Flash(clr color.NRGBA, duration float64) {
+	f.flashColor = clr
+	f.flashTime = duration
+	f.flashDuration = duration
+}
+
+// Update は揺れとフラッシュを dt 秒分減衰させ、ヒットストップを1フレーム進める
+// ヒットストップ中なら true を返す
+func (f *Feedback) Update(dt float64) bool {
+	f.trauma = math.Max(0, f.trauma-config.ShakeDecay*dt)
+	
+	// 揺れは強さの2乗に比例させ、弱い揺れはすぐに目立たなくなるようにする
+	magnitude := config.ShakeMaxOffset * f.trauma * f.trauma
+	f.offsetX = magnitude * (rand.Float64()*2 - 1)
+	f.offsetY = magnitude * (rand.Float64()*2 - 1)
+	
+	f.flashTime = math.Max(0, f.flashTime-dt)
+	
+	if f.hitStop > 0 {
+		f.hitStop--
+		return true
+	}
+	return false
+}
+
+// Offset は画面の揺れによるずれを返す（揺れが無効なら0）
+func (f *Feedback) Offset() (float64, float64) {
+	if !f.ShakeEnabled {
+		return 0, 0
+	}
+	return f.offsetX, f.offsetY
+}
+
+// FlashOverlay は画面に重ねるフラッシュの色を返す（フラッシュが無効か終わっていれば false）
+func (f *Feedback) FlashOverlay() (color.NRGBA, bool) {
+	if !f.FlashEnabled || f.flashTime <= 0 {
+		return color.NRGBA{}, false
+	}
+	alpha := float64(f.flashColor.A) * f.flashTime / f.flashDuration
+	clr := f.flashColor
+	clr.A = uint8(alpha)
+	return clr, true
+}
+
+// Reset は進行中の揺れ・ヒットストップ・フラッシュを止める（設定は保持）
+func (f *Feedback) Reset() {
+	f.trauma, f.offsetX, f.offsetY = 0, 0, 0
+	f.hitStop = 0
+	f.flashTime = 0
+}
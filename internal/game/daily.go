@@ -0,0 +1,68 @@
+package game
+
+import (
+	"log/slog"
+	"time"
+
+	"game/internal/config"
+	"game/internal/daily"
+)
+
+// dailyModifiers はデイリーチャレンジのルールの変化ごとの、プレイの始め方への反映のしかた
+var dailyModifiers = map[daily.Modifier]func(r *RunConfig){}
+
+// registerDailyModifier はルールの変化の反映のしかたを登録する
+func registerDailyModifier(m daily.Modifier, apply func(r *RunConfig)) {
+	dailyModifiers[m] = apply
+}
+
+func init() {
+	registerDailyModifier(daily.FastBullets, func(r *RunConfig) { r.BulletSpeedScale = config.DailyFastBulletScale })
+	registerDailyModifier(daily.NoShields, func(r *RunConfig) { r.NoShields = true })
+	registerDailyModifier(daily.DoubleGraze, func(r *RunConfig) { r.GrazeMultiplier = 2 })
+}
+
+// DailyChallenge は今日（ローカル時刻の暦日）のデイリーチャレンジを返す
+func (g *Game) DailyChallenge() daily.Challenge {
+	return daily.For(time.Now())
+}
+
+// DailyRun はデイリーチャレンジの始め方を返す（難易度は固定で、シードとルールの変化は日付で決まる）
+func DailyRun(c daily.Challenge) RunConfig {
+	run := RunConfig{Preset: config.DailyPreset, Seed: c.Seed, Daily: c.Date}
+	for _, m := range c.Modifiers {
+		if apply, ok := dailyModifiers[m]; ok {
+			apply(&run)
+		}
+	}
+	return run
+}
+
+// StartDaily は今日のデイリーチャレンジを開始する
+// ランキング対象はその日の最初の挑戦だけで、途中でやめても挑戦したことになる
+// アシストモードでの挑戦はランキング対象外で、その日の挑戦としても数えない
+func (g *Game) StartDaily() {
+	c := g.DailyChallenge()
+	run := DailyRun(c)
+	run.Unranked = g.Daily.Attempted(c.Date)
+	g.StartRun(run)
+
+	if g.Run.Ranked() {
+		g.Daily.Begin(c.Date)
+		g.saveDaily()
+	}
+}
+
+// recordDaily はデイリーチャレンジの結果を記録して保存する
+func (g *Game) recordDaily() {
+	g.Daily.Finish(g.Run.Daily, g.CurrentTime, g.Score, g.Grazes)
+	g.saveDaily()
+	slog.Info("デイリーチャレンジの結果", "date", g.Run.Daily, "time", g.CurrentTime, "score", g.Score, "streak", g.Daily.CurrentStreak(g.Run.Daily))
+}
+
+// saveDaily はデイリーチャレンジの記録を保存する（保存できなくてもゲームは続ける）
+func (g *Game) saveDaily() {
+	if err := g.Daily.Save(); err != nil {
+		slog.Warn("デイリーチャレンジの記録を保存できません", "err", err)
+	}
+}
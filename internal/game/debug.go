@@ -0,0 +1,45 @@
+package game
+
+import (
+	"fmt"
+
+	"game/internal/config"
+	"game/internal/pattern"
+)
+
+// markDebugged はデバッグ用の操作でプレイの内容を変えたことを記録する
+// 以降このプレイはランキング・デイリーチャレンジの記録・通算の統計・実績の対象外になる
+func (g *Game) markDebugged() {
+	g.Run.Debugged = true
+	g.Stats.Exclude()
+	g.Achievements.Exclude()
+}
+
+// SetDifficulty は難易度レベルを直接設定する（次のレベルアップまでの間隔は設定した時点から数え直す）
+func (g *Game) SetDifficulty(level int) {
+	g.markDebugged()
+	g.Difficulty = max(1, level)
+	g.DifficultyElapsed = 0
+}
+
+// SpawnPattern は現在の難易度に応じた弾速で、指定した弾幕パターンを1回発射する
+func (g *Game) SpawnPattern(name string) error {
+	if _, ok := pattern.Get(name); !ok {
+		return fmt.Errorf("弾幕パターン %q は登録されていません", name)
+	}
+	g.markDebugged()
+	g.spawnPattern(name, g.Curve.At(g.Difficulty).BulletsPerWave)
+	return nil
+}
+
+// GiveShield はシールドアイテムを取ったときと同じ耐久度のシールドを与える
+func (g *Game) GiveShield() {
+	g.markDebugged()
+	g.Player.AddShield(config.Current().Shield.Durability)
+}
+
+// ToggleGodMode は無敵モード（弾に当たってもやられない）を切り替える
+func (g *Game) ToggleGodMode() {
+	g.markDebugged()
+	g.GodMode = !g.GodMode
+}
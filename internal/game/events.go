@@ -0,0 +1,131 @@
+package game
+
+import (
+	"context"
+	"image/color"
+	"log/slog"
+
+	"game/internal/audio"
+	"game/internal/config"
+	"game/internal/event"
+	"game/internal/particle"
+	"game/internal/theme"
+)
+
+// emit はゲーム内の出来事を購読者に配信する
+func (g *Game) emit(e event.Event) {
+	g.Events.Emit(e)
+}
+
+// subscribe はゲーム内の出来事に効果音・粒子と画面効果・難易度調整・ログ・統計と実績を結び付ける
+// 新しい仕組みは update.go を変えずに、ここで購読者を登録して出来事に反応させる
+func (g *Game) subscribe() {
+	g.subscribeAudio()
+	g.subscribeEffects()
+	g.subscribeDirector()
+	g.subscribeLog()
+	
+	// 実績はすべての出来事の購読者なので、統計が更新された後に判定される
+	g.Stats.Subscribe(g.Events)
+	g.Achievements.Subscribe(g.Events)
+}
+
+// subscribeAudio は出来事ごとの効果音を登録する
+func (g *Game) subscribeAudio() {
+	event.Subscribe(g.Events, func(event.BombUsed) { g.Audio.Play(audio.SoundBomb) })
+	event.Subscribe(g.Events, func(event.ShieldPicked) { g.Audio.Play(audio.SoundShieldPickup) })
+	event.Subscribe(g.Events, func(event.ShieldHit) { g.Audio.Play(audio.SoundShieldHit) })
+	event.Subscribe(g.Events, func(event.Grazed) { g.Audio.Play(audio.SoundGraze) })
+	event.Subscribe(g.Events, func(event.DifficultyUp) { g.Audio.Play(audio.SoundLevelUp) })
+	event.Subscribe(g.Events, func(event.PlayerDied) { g.Audio.Play(audio.SoundDeath) })
+	event.Subscribe(g.Events, func(event.PlayerMissed) { g.Audio.Play(audio.SoundDeath) })
+}
+
+// subscribeEffects は出来事ごとの粒子と画面の揺れ・ヒットストップ・フラッシュを登録する
+func (g *Game) subscribeEffects() {
+	// 取ったアイテムの種類の色の粒子を散らす
+	event.Subscribe(g.Events, func(e event.ItemPicked) {
+		g.Particles.Burst(particle.PickupBurst.WithColor(itemRegistry[e.Kind].burst), e.X, e.Y, config.PickupBurstCount)
+	})
+	// ボムで消した弾から弾の色の火花を散らす
+	event.Subscribe(g.Events, func(e event.BulletCleared) {
+		for _, b := range e.Bullets {
+			g.Particles.Burst(particle.CancelSpark.WithColor(theme.Current().BulletColor(b.Kind, b.SpeedTier())), b.X, b.Y, config.CancelSparkCount)
+		}
+	})
+	event.Subscribe(g.Events, func(event.BombUsed) {
+		g.Feedback.Shake(config.BombShake)
+		g.Feedback.Flash(color.NRGBA{255, 255, 255, 120}, config.BombFlash)
+	})
+	event.Subscribe(g.Events, func(e event.ShieldHit) {
+		g.Particles.Burst(particle.ShieldSpark, e.X, e.Y, config.ShieldSparkCount)
+		g.Feedback.Shake(config.ShieldHitShake)
+		g.Feedback.HitStop(config.ShieldHitStop)
+		g.Feedback.Flash(color.NRGBA{0, 200, 255, 90}, config.ShieldHitFlash)
+	})
+	
+	// プレイヤーの爆発と飛び散る破片
+	event.Subscribe(g.Events, func(e event.PlayerDied) {
+		g.Particles.Burst(particle.DeathBlast, e.X, e.Y, config.DeathBlastCount)
+		g.Particles.Stream(particle.DeathDebris, e.X, e.Y, config.DeathDebrisDuration)
+		g.Feedback.Shake(config.DeathShake)
+		g.Feedback.HitStop(config.DeathHitStop)
+		g.Feedback.Flash(color.NRGBA{255, 60, 40, 200}, config.DeathFlash)
+	})
+	
+	// 残機無制限のプレイでの被弾は爆発だけで、破片は降らせない
+	event.Subscribe(g.Events, func(e event.PlayerMissed) {
+		g.Particles.Burst(particle.DeathBlast, e.X, e.Y, config.DeathBlastCount)
+		g.Feedback.Shake(config.DeathShake)
+		g.Feedback.Flash(color.NRGBA{255, 60, 40, 120}, config.DeathFlash)
+	})
+}
+
+// subscribeDirector はアシストモードの負荷の評価に使う出来事を登録する
+func (g *Game) subscribeDirector() {
+	event.Subscribe(g.Events, func(event.BombUsed) { g.Director.RecordBomb() })
+	event.Subscribe(g.Events, func(event.ShieldHit) { g.Director.RecordShieldHit() })
+	event.Subscribe(g.Events, func(event.Grazed) { g.Director.RecordNearMiss() })
+}
+
+// subscribeLog は出来事を構造化ログに出力する
+// 1フレームに何度も起こりうる弾の消去・シールド被弾・グレイズはデバッグ用の重要度で出力する
+func (g *Game) subscribeLog() {
+	event.Subscribe(g.Events, func(e event.BulletCleared) {
+		g.logEvent(slog.LevelDebug, "爆発スキルで弾を消去", e, "cleared", len(e.Bullets))
+	})
+	event.Subscribe(g.Events, func(e event.DifficultyUp) {
+		g.logEvent(slog.LevelInfo, "難易度上昇", e, "level", e.Level)
+	})
+	event.Subscribe(g.Events, func(e event.ItemPicked) {
+		g.logEvent(slog.LevelDebug, "アイテム獲得", e, "kind", int(e.Kind))
+	})
+	event.Subscribe(g.Events, func(e event.ShieldPicked) {
+		g.logEvent(slog.LevelInfo, "シールド獲得", e, "durability", e.Durability)
+	})
+	event.Subscribe(g.Events, func(e event.ShieldHit) {
+		g.logEvent(slog.LevelDebug, "シールドが弾を防いだ", e, "remaining", e.Remaining)
+	})
+	event.Subscribe(g.Events, func(e event.BombUsed) {
+		g.logEvent(slog.LevelInfo, "ボム使用", e, "bomb", config.Current().Bomb(e.Kind).Name)
+	})
+	event.Subscribe(g.Events, func(e event.Grazed) {
+		g.logEvent(slog.LevelDebug, "グレイズ", e, "grazes", e.Total)
+	})
+	event.Subscribe(g.Events, func(e event.PlayerDied) {
+		g.logEvent(slog.LevelInfo, "ゲームオーバー", e, "time", e.Time, "score", e.Score, "grazes", e.Grazes, "assist", e.Assist, "practice", e.Practice)
+	})
+	event.Subscribe(g.Events, func(e event.PlayerMissed) {
+		g.logEvent(slog.LevelInfo, "被弾（残機無制限）", e, "misses", e.Misses)
+	})
+}
+
+// logEvent は出来事をゲームの状態（フレーム数・難易度・弾の数）とともにログに出力する
+func (g *Game) logEvent(level slog.Level, msg string, e event.Event, args ...any) {
+	ctx := context.Background()
+	if !slog.Default().Enabled(ctx, level) {
+		return
+	}
+	args = append([]any{"event", e.Name(), "tick", g.Tick, "difficulty", g.Difficulty, "bullets", len(g.Bullets)}, args...)
+	slog.Log(ctx, level, msg, args...)
+}
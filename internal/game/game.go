@@ -1,23 +1,55 @@
 package game
 
 import (
-	"sort"
+	"log/slog"
+	"math/rand"
 	"time"
 
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"game/internal/achievement"
+	"game/internal/audio"
 	"game/internal/config"
+	"game/internal/daily"
+	"game/internal/dda"
+	"game/internal/difficulty"
+	"game/internal/display"
 	"game/internal/entity"
+	"game/internal/event"
+	"game/internal/feedback"
+	"game/internal/particle"
+	"game/internal/pattern"
+	"game/internal/spawn"
+	"game/internal/stats"
+)
+
+// Scene はゲームの画面の種類
+type Scene int
+
+// ゲームの画面の種類
+const (
+	SceneTitle    Scene = iota // タイトル画面（難易度選択）
+	ScenePlaying               // プレイ中
+	SceneGameOver              // ゲームオーバー
+	SceneSettings              // 設定画面（タイトル画面から開く）
+	ScenePaused                // 一時停止（弾の凡例を表示する）
+	ScenePractice              // 練習モードの設定（タイトル画面から開く）
 )
 
 // Game はゲームの状態を管理する構造体
 type Game struct {
+	Scene         Scene
 	Player        *entity.Player
 	Bullets       []*entity.Bullet
-	ShieldItem    *entity.ShieldItem
-	GameOver      bool
-	StartTime     time.Time
-	CurrentTime   float64
-	Scores        []float64
-	LastBulletAdd time.Time
+	Items         []entity.Item
+	CurrentTime   float64   // プレイ開始からのゲーム内の経過時間（一時停止中は進まず、時間の流れの倍率に従う）
+	Tick          int       // プレイ開始からゲームが進んだフレーム数（ヒットストップ中と一時停止中は進まない）
+	Run           RunConfig  // このプレイの始め方（やり直すときも同じ始め方を使う）
+	Seed          int64      // このプレイの乱数のシード（同じシードなら同じ弾幕とアイテムの並びになる）
+	Rand          *rand.Rand // 弾幕・アイテムの抽選と出現位置に使う乱数
+	Rankings      Rankings
+	Viewport      *display.Viewport // ウィンドウへの拡大縮小とカーソル座標の変換（プレイをまたいで保持）
+	BulletSpawnElapsed float64 // 前回の弾の発射からの経過時間（弾の時間の流れに従う）
 	
 	// UI効果用の変数
 	GameOverAlpha    float64
@@ -26,24 +58,107 @@ type Game struct {
 	ScoreAnimations  []*entity.ScoreAnimation
 	
 	// 難易度関連の変数
+	Preset           config.DifficultyKind // 選択した難易度プリセット
+	TitleSelection   config.DifficultyKind // タイトル画面で選択中の難易度
+	SettingsSelection int                  // 設定画面で選択中の項目
+	PracticeSelection int                  // 練習モードの設定画面で選択中の項目
+	PracticeRun      RunConfig             // 練習モードの設定画面で選んでいる始め方（プレイをまたいで保持）
 	Difficulty       int
-	LastDifficultyIncrease time.Time
+	Curve            *difficulty.Curve     // 難易度レベルに対する弾幕の変化
+	DifficultyElapsed float64          // 前回の難易度上昇からのゲーム内の経過時間
+	
+	// アシストモード（動的難易度調整）関連
+	Assist           bool          // タイトル画面で選んだアシストモードの設定（プレイを始めるときに RunConfig.Assist に写す）
+	Director         *dda.Director // 弾幕の強さを調整する難易度調整器（プレイをまたいで保持）
 	
 	// 爆発関連
 	Explosion     *entity.Explosion
+	
+	// 火花や破片などの粒子
+	Particles     *particle.System
+	
+	// 画面の揺れ・ヒットストップ・フラッシュ（揺れとフラッシュの設定はプレイをまたいで保持）
+	Feedback      *feedback.Feedback
+	
+	// 効果音とBGM（プレイをまたいで保持、初期化できなかった場合はnilで音を鳴らさない）
+	Audio         *audio.Mixer
+	
+	// デイリーチャレンジの挑戦の記録（プレイをまたいで保持）
+	Daily         *daily.History
+	
+	// ゲーム内の出来事の配信と、それを数える統計・実績（プレイをまたいで保持）
+	Events        *event.Bus
+	Stats         *stats.Stats
+	Achievements  *achievement.Tracker
+	
+	// アイテム関連
+	ItemSpawnTimer float64 // 次のアイテム出現までの時間
+	Placer         *spawn.Placer // アイテムなどの出現位置を選ぶ配置サービス
+	SlowMotionTime     float64 // スローモーションの残り時間
+	SlowMotionDuration float64 // 現在のスローモーションの効果時間（ゲージ表示用）
+	
+	// 得点関連
+	Grazes        int                 // 弾がプレイヤーをかすめた回数
+	Misses        int                 // 残機無制限のプレイで被弾した回数
+	Score         int                 // ボムで得た得点
+	ScoreItems    []*entity.ScoreItem // プレイヤーへ向かう得点アイテム
+	
+	// 開発用（開発用ビルドのデバッグキー・コンソール・オーバーレイから使う）
+	GodMode         bool    // 弾に当たってもやられない
+	TimeScale       float64 // ゲーム内の時間の流れの倍率（すべてのタイマーと移動に掛かる）
+	Frozen          bool    // ゲーム内の時間を止めているかどうか（コマ送りでだけ進む）
+	PendingSteps    int     // 停止中にコマ送りで進める残りフレーム数
+	CollisionChecks int     // このフレームに行った当たり判定の回数
+	stepScale       float64 // このフレームに進める時間の倍率（TimeScale か、コマ送り中は等倍）
 }
 
-// NewGame は新しいゲームインスタンスを作成する
-func NewGame() *Game {
+// NewGame は新しいゲームインスタンスを作成する（タイトル画面から始まり、背景には run の始め方の弾幕を流す）
+func NewGame(run RunConfig) *Game {
+	g := newRun(run)
+	g.Scene = SceneTitle
+	g.TitleSelection = run.Preset
+	g.Rankings = make(Rankings)
+	g.Director = dda.NewDirector()
+	g.Viewport = display.NewViewport()
+	g.Feedback = feedback.New()
+	g.Daily = &daily.History{}
+	
+	mixer, err := audio.New()
+	if err != nil {
+		slog.Warn("音声を初期化できないため音なしで続けます", "err", err)
+	}
+	g.Audio = mixer
+	
+	g.Events = event.NewBus()
+	g.Stats = stats.New()
+	g.Achievements = achievement.NewTracker(g.Stats)
+	g.subscribe()
+	return g
+}
+
+// newSeed は現在時刻から乱数のシードを作る
+func newSeed() int64 {
+	return time.Now().UnixNano()
+}
+
+// newRun は指定した始め方で1回分のプレイの状態を作成する
+func newRun(run RunConfig) *Game {
+	cfg := config.Current()
+	preset := run.Preset
+	settings := cfg.Preset(preset)
+	seed := run.seed()
+	rng := rand.New(rand.NewSource(seed))
+	
 	g := &Game{
-		Player:        entity.NewPlayer(float64(config.ScreenWidth)/2, float64(config.ScreenHeight)/2, config.PlayerSize),
-		Bullets:       make([]*entity.Bullet, 0, config.InitialBullets),
-		ShieldItem:    entity.NewShieldItem(config.ShieldItemSize),
-		GameOver:      false,
-		StartTime:     time.Now(),
+		Scene:         ScenePlaying,
+		Run:           run,
+		Seed:          seed,
+		Rand:          rng,
+		Player:        entity.NewPlayer(float64(config.ScreenWidth)/2, float64(config.ScreenHeight)/2, cfg.Player.Size),
+		Bullets:       make([]*entity.Bullet, 0, cfg.Bullet.Initial),
+		Items:         make([]entity.Item, 0, cfg.Items.Max),
 		CurrentTime:   0,
-		Scores:        make([]float64, 0, config.MaxRankingScores),
-		LastBulletAdd: time.Now(),
+		BulletSpawnElapsed: 0,
 		
 		// UI効果の初期化
 		GameOverAlpha: 0,
@@ -52,54 +167,197 @@ func NewGame() *Game {
 		ScoreAnimations: make([]*entity.ScoreAnimation, 0),
 		
 		// 難易度の初期化
-		Difficulty: 1,
-		LastDifficultyIncrease: time.Now(),
+		Preset:     preset,
+		TitleSelection: preset,
+		Difficulty: run.startLevel(),
+		Curve:      difficulty.For(preset),
+		DifficultyElapsed: 0,
 		
 		// 爆発は初期状態ではnil
 		Explosion: nil,
+		Particles: particle.NewSystem(config.MaxParticles),
+		
+		// アイテムの初期化
+		ItemSpawnTimer: nextItemSpawnDelay(rng, settings.ItemSpawnInterval),
+		Placer:         spawn.NewPlacer(config.ScreenWidth, config.ScreenHeight, rng),
+		SlowMotionTime: 0,
+		SlowMotionDuration: 0,
+		
+		// 得点の初期化
+		Score:      0,
+		ScoreItems: make([]*entity.ScoreItem, 0),
+		
+		TimeScale: 1.0,
+		stepScale: 1.0,
 	}
 
-	// 初期の弾を生成
-	for i := 0; i < config.InitialBullets; i++ {
-		g.addRandomBullet()
+	// 難易度に応じてボムのクールダウンを調整
+	g.Player.SetBombCooldownScale(settings.BombCooldownScale)
+
+	// 初期の弾を生成（弾幕パターンを固定したプレイではそのパターンで始める）
+	if run.Pattern != "" {
+		g.spawnPattern(run.Pattern, g.Curve.At(g.Difficulty).BulletsPerWave)
+	} else {
+		g.spawnPattern("random", cfg.Bullet.Initial)
 	}
 
 	return g
 }
 
-// addRandomBullet はランダムな位置と速度で新しい弾を追加する
-func (g *Game) addRandomBullet() {
-	bullet := entity.NewRandomBullet(config.ScreenWidth, config.ScreenHeight, config.BulletSize, config.BulletSpeedMin, config.BulletSpeedMax, g.Difficulty)
-	g.Bullets = append(g.Bullets, bullet)
+// spawnPattern は現在の難易度に応じた弾速で、指定した弾幕パターンの弾を追加する
+func (g *Game) spawnPattern(name string, count int) {
+	params := g.Curve.At(g.Difficulty)
+	bullet := config.Current().Bullet
+	bullets := pattern.Spawn(name, pattern.Context{
+		Width:           config.ScreenWidth,
+		Height:          config.ScreenHeight,
+		BulletSize:      bullet.Size,
+		MinSpeed:        bullet.SpeedMin,
+		MaxSpeed:        bullet.SpeedMax,
+		SpeedMultiplier: params.SpeedMultiplier * g.assistFactor() * g.Run.bulletSpeedScale(),
+		TargetX:         g.Player.X,
+		TargetY:         g.Player.Y,
+		Count:           count,
+		Rand:            g.Rand,
+	})
+	g.Bullets = append(g.Bullets, bullets...)
 }
 
-// Layout はウィンドウサイズを返す
+// Layout はウィンドウサイズに合わせたスクリーンのサイズを返す
+// プレイフィールドは常に論理サイズで描かれ、描画時にスクリーンへ拡大縮小される
 func (g *Game) Layout(outsideWidth, outsideHeight int) (int, int) {
-	return config.ScreenWidth, config.ScreenHeight
+	return g.Viewport.Layout(outsideWidth, outsideHeight, ebiten.Monitor().DeviceScaleFactor())
 }
 
-// Reset はゲームをリセットする（スコアは保持）
-func (g *Game) Reset() {
-	oldScores := g.Scores
-	*g = *NewGame()
-	g.Scores = oldScores
+// ApplyConfig は設定を差し替え、実行中のゲームに反映する
+// 弾速やアイテムの設定は次に生成されるものから、プレイヤーと出現位置の選び方の設定は即座に反映される
+func (g *Game) ApplyConfig(c *config.Config) {
+	config.Set(c)
+	
+	g.Player.Resize(c.Player.Size, c.Items.ShrinkScale)
+	g.Player.SetBombCooldownScale(g.PresetSettings().BombCooldownScale)
+	g.Player.SetSlowSkillCooldownMax(c.SlowMotion.SkillCooldown)
+	g.Placer.Configure(c.Spawn)
 }
 
-// AddScore はスコアを追加する
-func (g *Game) AddScore(score float64) {
-	g.Scores = append(g.Scores, score)
+// PresetSettings は選択中の難易度プリセットの設定を返す
+func (g *Game) PresetSettings() config.DifficultyPreset {
+	return config.Current().Preset(g.Preset)
+}
+
+// Start は指定した難易度で通常のプレイを開始する
+func (g *Game) Start(preset config.DifficultyKind) {
+	g.StartRun(NormalRun(preset))
+}
+
+// StartRun は指定した始め方でプレイを開始する
+// ランキング、アシストモード、練習モードの設定、デイリーチャレンジの記録、画面設定、演出と音声の設定、出来事の購読者、統計と実績は保持する
+func (g *Game) StartRun(run RunConfig) {
+	prev := *g
+	run.Assist = prev.Assist
+	*g = *newRun(run)
+	g.Rankings = prev.Rankings
+	g.Assist = prev.Assist
+	g.PracticeRun = prev.PracticeRun
+	g.Daily = prev.Daily
+	g.Director = prev.Director
+	g.Viewport = prev.Viewport
+	g.Feedback = prev.Feedback
+	g.Audio = prev.Audio
+	g.Events = prev.Events
+	g.Stats = prev.Stats
+	g.Achievements = prev.Achievements
 	
-	// スコアを降順にソート
-	sort.Slice(g.Scores, func(i, j int) bool {
-		return g.Scores[i] > g.Scores[j]
+	g.Director.BeginRun()
+	g.Feedback.Reset()
+	g.Stats.BeginRun(run.Ranked())
+	g.Achievements.BeginRun(run.Ranked())
+}
+
+// Reset は同じ始め方でゲームをやり直す（スコアは保持）
+// デイリーチャレンジはその日の挑戦としてやり直す（2回目以降はランキング対象外）
+func (g *Game) Reset() {
+	if g.Run.Daily != "" {
+		g.StartDaily()
+		return
+	}
+	g.StartRun(g.Run)
+}
+
+// ReturnToTitle はタイトル画面に戻る（スコアは保持、背景には通常のプレイの弾幕を流す）
+func (g *Game) ReturnToTitle() {
+	g.Start(g.Preset)
+	g.Scene = SceneTitle
+}
+
+// assistFactor はアシストモードの難易度係数を返す（通常モードでは1）
+func (g *Game) assistFactor() float64 {
+	if !g.Run.Assist {
+		return 1.0
+	}
+	return g.Director.Factor
+}
+
+// endRun はゲームオーバーにして結果を記録する
+func (g *Game) endRun() {
+	g.Scene = SceneGameOver
+	g.emit(event.PlayerDied{
+		X:          g.Player.X,
+		Y:          g.Player.Y,
+		Time:       g.CurrentTime,
+		Difficulty: g.Difficulty,
+		Score:      g.Score,
+		Grazes:     g.Grazes,
+		Assist:     g.Run.Assist,
+		Practice:   g.Run.Practice,
 	})
 	
-	// 上位スコアだけを保持
-	if len(g.Scores) > config.MaxRankingScores {
-		g.Scores = g.Scores[:config.MaxRankingScores]
+	// アシストモードの結果はランキングに載せず、調整履歴をログに残す
+	if g.Run.Assist {
+		g.Director.RecordDeath(g.CurrentTime)
+		slog.Info("アシストモードのプレイ終了", "time", g.CurrentTime, "adjustments", len(g.Director.History))
+		for _, a := range g.Director.History {
+			slog.Debug("アシストモードの調整", "adjustment", a.String())
+		}
+	}
+	
+	// 練習モード・2回目以降のデイリーチャレンジ・デバッグ操作をしたプレイの結果もランキングに載せない
+	if !g.Run.Ranked() {
+		g.addScoreAnimation(g.CurrentTime)
+		return
 	}
 	
-	// スコアアニメーションを追加
+	// デイリーチャレンジの結果は難易度ごとのランキングではなく、デイリーチャレンジの記録に残す
+	if g.Run.Daily != "" {
+		g.recordDaily()
+		g.addScoreAnimation(g.CurrentTime)
+		return
+	}
+	
+	// スコアを記録
+	g.AddScore(g.CurrentTime)
+}
+
+// miss は残機無制限のプレイでの被弾を数え、しばらく無敵にする
+func (g *Game) miss() {
+	g.Misses++
+	g.Player.InvincibleTime = config.MissInvincibleTime
+	g.emit(event.PlayerMissed{X: g.Player.X, Y: g.Player.Y, Misses: g.Misses})
+}
+
+// AddScore はスコアを選択中の難易度のランキングに追加する
+func (g *Game) AddScore(score float64) {
+	g.Rankings.Add(ScoreRecord{
+		Time:   score,
+		Score:  g.Score,
+		Preset: g.Preset,
+	})
+	
+	g.addScoreAnimation(score)
+}
+
+// addScoreAnimation はスコアアニメーションを追加する
+func (g *Game) addScoreAnimation(score float64) {
 	g.ScoreAnimations = append(g.ScoreAnimations, entity.NewScoreAnimation(
 		score,
 		config.ScreenWidth / 2,
@@ -0,0 +1,246 @@
+package game
+
+import (
+	"image/color"
+	"math/rand"
+
+	"game/internal/config"
+	"game/internal/entity"
+	"game/internal/event"
+)
+
+// itemType はアイテムの種類ごとの登録情報
+type itemType struct {
+	spec   func(items *config.ItemsConfig) config.ItemSpec // 現在の設定からこの種類の設定を取り出す
+	create func(x, y float64, spec config.ItemSpec) entity.Item
+	pickup func(g *Game, item entity.Item, spec config.ItemSpec)
+	size   func(cfg *config.Config) float64 // アイテムのサイズ（nilならアイテム共通のサイズ）
+	burst  color.RGBA // 取得したときに飛び散る粒子の色
+}
+
+// itemRegistry は出現しうるアイテムの種類の一覧
+var itemRegistry = map[entity.ItemKind]itemType{}
+
+// itemKinds は登録順のアイテムの種類（抽選結果を安定させるため）
+var itemKinds []entity.ItemKind
+
+// registerItem はアイテムの種類を登録する
+func registerItem(kind entity.ItemKind, t itemType) {
+	if _, exists := itemRegistry[kind]; !exists {
+		itemKinds = append(itemKinds, kind)
+	}
+	itemRegistry[kind] = t
+}
+
+// currentSpec は現在の設定でのアイテムの設定を返す
+func (t itemType) currentSpec() config.ItemSpec {
+	return t.spec(&config.Current().Items)
+}
+
+// itemSize は現在の設定でのアイテムのサイズを返す（出現位置を選ぶときの余白にも使う）
+func (t itemType) itemSize() float64 {
+	if t.size != nil {
+		return t.size(config.Current())
+	}
+	return config.Current().Items.Size
+}
+
+// newPowerUp は効果時間を持つ汎用アイテムの生成関数を返す
+func newPowerUp(kind entity.ItemKind) func(x, y float64, spec config.ItemSpec) entity.Item {
+	return func(x, y float64, spec config.ItemSpec) entity.Item {
+		items := config.Current().Items
+		return entity.NewPowerUpItem(kind, x, y, items.Size, spec.Lifetime, items.BlinkTime, spec.Duration)
+	}
+}
+
+func init() {
+	registerItem(entity.ItemShield, itemType{
+		spec: func(items *config.ItemsConfig) config.ItemSpec { return items.Shield },
+		create: func(x, y float64, spec config.ItemSpec) entity.Item {
+			cfg := config.Current()
+			return entity.NewShieldItem(x, y, cfg.Shield.ItemSize, spec.Lifetime, cfg.Items.BlinkTime, cfg.Shield.Durability)
+		},
+		size: func(cfg *config.Config) float64 { return cfg.Shield.ItemSize },
+		pickup: func(g *Game, item entity.Item, spec config.ItemSpec) {
+			g.Player.AddShield(item.(*entity.ShieldItem).Durability)
+			g.emit(event.ShieldPicked{X: item.Base().X, Y: item.Base().Y, Durability: g.Player.Shield})
+		},
+		burst:  color.RGBA{0, 200, 255, 255},
+	})
+	registerItem(entity.ItemBombRefill, itemType{
+		spec:   func(items *config.ItemsConfig) config.ItemSpec { return items.BombRefill },
+		create: newPowerUp(entity.ItemBombRefill),
+		pickup: func(g *Game, item entity.Item, spec config.ItemSpec) {
+			g.Player.RefillBomb()
+		},
+		burst:  color.RGBA{255, 140, 0, 255},
+	})
+	registerItem(entity.ItemSlowMotion, itemType{
+		spec:   func(items *config.ItemsConfig) config.ItemSpec { return items.SlowMotion },
+		create: newPowerUp(entity.ItemSlowMotion),
+		pickup: func(g *Game, item entity.Item, spec config.ItemSpec) {
+			g.startSlowMotion(spec.Duration)
+		},
+		burst:  color.RGBA{160, 100, 255, 255},
+	})
+	registerItem(entity.ItemMagnet, itemType{
+		spec:   func(items *config.ItemsConfig) config.ItemSpec { return items.Magnet },
+		create: newPowerUp(entity.ItemMagnet),
+		pickup: func(g *Game, item entity.Item, spec config.ItemSpec) {
+			g.Player.MagnetTime = spec.Duration
+		},
+		burst:  color.RGBA{220, 50, 80, 255},
+	})
+	registerItem(entity.ItemScoreGem, itemType{
+		spec: func(items *config.ItemsConfig) config.ItemSpec { return items.ScoreGem },
+		create: func(x, y float64, spec config.ItemSpec) entity.Item {
+			items := config.Current().Items
+			return entity.NewScoreGemItem(x, y, items.Size, spec.Lifetime, items.BlinkTime, items.ScoreGemValue)
+		},
+		pickup: func(g *Game, item entity.Item, spec config.ItemSpec) {
+			g.Score += item.(*entity.ScoreGemItem).Value
+		},
+		burst:  color.RGBA{255, 230, 80, 255},
+	})
+	registerItem(entity.ItemShrink, itemType{
+		spec:   func(items *config.ItemsConfig) config.ItemSpec { return items.Shrink },
+		create: newPowerUp(entity.ItemShrink),
+		pickup: func(g *Game, item entity.Item, spec config.ItemSpec) {
+			g.Player.Shrink(spec.Duration, config.Current().Items.ShrinkScale)
+		},
+		burst:  color.RGBA{80, 220, 120, 255},
+	})
+	registerItem(entity.ItemInvincible, itemType{
+		spec:   func(items *config.ItemsConfig) config.ItemSpec { return items.Invincible },
+		create: newPowerUp(entity.ItemInvincible),
+		pickup: func(g *Game, item entity.Item, spec config.ItemSpec) {
+			g.Player.InvincibleTime = spec.Duration
+		},
+		burst:  color.RGBA{255, 215, 0, 255},
+	})
+}
+
+// itemWeight は難易度を考慮したアイテムの出現の重みを返す
+func (g *Game) itemWeight(kind entity.ItemKind) float64 {
+	weight := itemRegistry[kind].currentSpec().Weight
+	if kind == entity.ItemShield {
+		// シールドなしのプレイでは出現させない
+		if g.Run.NoShields {
+			return 0
+		}
+		weight *= g.PresetSettings().ShieldWeightScale
+	}
+	return weight
+}
+
+// pickItemKind は出現の重みに従ってアイテムの種類を抽選する
+// 出現しうるアイテムがない（重みの合計が0の）場合、okはfalseになる
+func (g *Game) pickItemKind() (kind entity.ItemKind, ok bool) {
+	total := 0.0
+	for _, k := range itemKinds {
+		total += g.itemWeight(k)
+	}
+	if total <= 0 {
+		return 0, false
+	}
+	
+	r := g.Rand.Float64() * total
+	for _, k := range itemKinds {
+		w := g.itemWeight(k)
+		if w <= 0 {
+			continue
+		}
+		// 丸め誤差で最後まで r が残った場合に備え、重みのある最後の種類を選んでおく
+		kind, ok = k, true
+		r -= w
+		if r < 0 {
+			break
+		}
+	}
+	return kind, ok
+}
+
+// nextItemSpawnDelay は次のアイテム出現までの時間を返す（揺らぎは rng から引く）
+func nextItemSpawnDelay(rng *rand.Rand, interval float64) float64 {
+	return interval + (rng.Float64()*2-1)*config.Current().Items.SpawnJitter
+}
+
+// spawnItem は抽選したアイテムを安全な位置に出現させる
+// 安全な位置が見つからなかった場合はfalseを返す（出現しうるアイテムがなければ何もせずtrueを返す）
+func (g *Game) spawnItem() bool {
+	kind, ok := g.pickItemKind()
+	if !ok {
+		return true
+	}
+	t := itemRegistry[kind]
+	
+	x, y, ok := g.Placer.Place(t.itemSize(), g.Player, g.Bullets, g.bulletTimeScale())
+	if !ok {
+		return false
+	}
+	
+	g.Items = append(g.Items, t.create(x, y, t.currentSpec()))
+	return true
+}
+
+// updateItems はアイテムの出現・寿命・取得を更新する
+func (g *Game) updateItems() {
+	// 一定間隔でアイテムを出現させる
+	g.ItemSpawnTimer -= g.deltaTime()
+	if g.ItemSpawnTimer <= 0 {
+		g.ItemSpawnTimer = nextItemSpawnDelay(g.Rand, g.PresetSettings().ItemSpawnInterval)
+		if len(g.Items) < config.Current().Items.Max && !g.spawnItem() {
+			// 安全な位置がなければ少し待ってから再試行する
+			g.ItemSpawnTimer = config.Current().Spawn.RetryDelay
+		}
+	}
+	
+	newItems := g.Items[:0]
+	for _, item := range g.Items {
+		item.Update(g.deltaTime())
+		
+		// マグネット効果中は範囲内のアイテムを引き寄せる
+		base := item.Base()
+		if g.Player.HasMagnet() {
+			dx := base.X - g.Player.X
+			dy := base.Y - g.Player.Y
+			items := config.Current().Items
+			if dx*dx+dy*dy < items.MagnetRadius*items.MagnetRadius {
+				base.MoveToward(g.Player.X, g.Player.Y, items.MagnetPullSpeed*g.stepScale)
+			}
+		}
+		
+		// アイテムとプレイヤーの衝突判定
+		g.CollisionChecks++
+		if item.CollidesWith(g.Player.X, g.Player.Y, g.Player.Size) {
+			t := itemRegistry[item.Kind()]
+			t.pickup(g, item, t.currentSpec())
+			g.emit(event.ItemPicked{Kind: item.Kind(), X: base.X, Y: base.Y})
+			item.Deactivate()
+		}
+		
+		if item.IsActive() {
+			newItems = append(newItems, item)
+		}
+	}
+	g.Items = newItems
+}
+
+// startSlowMotion はスローモーションを開始する（効果中なら長い方の残り時間を採用する）
+func (g *Game) startSlowMotion(duration float64) {
+	if duration > g.SlowMotionTime {
+		g.SlowMotionTime = duration
+		g.SlowMotionDuration = duration
+	}
+}
+
+// bulletTimeScale は現在の弾の時間の流れの速さを返す
+func (g *Game) bulletTimeScale() float64 {
+	if g.Explosion != nil && g.Explosion.StopsTime() {
+		return 0
+	}
+	if g.SlowMotionTime > 0 {
+		return config.Current().SlowMotion.Factor * g.stepScale
+	}
+	return g.stepScale
+}
@@ -0,0 +1,33 @@
+package game
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// Pause はプレイを一時停止する
+// 経過時間はプレイ中のフレームでだけ進むため、止まっていた時間は経過時間と難易度上昇の間隔に含まれない
+func (g *Game) Pause() {
+	g.Scene = ScenePaused
+}
+
+// Resume は一時停止したプレイを再開する
+func (g *Game) Resume() {
+	g.Scene = ScenePlaying
+}
+
+// updatePaused は一時停止画面の更新処理
+func (g *Game) updatePaused() error {
+	// PキーまたはEscキーで再開
+	if inpututil.IsKeyJustPressed(ebiten.KeyP) || inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		g.Resume()
+		return nil
+	}
+	
+	// Qキーでプレイをやめてタイトルへ戻る（記録は残らない）
+	if inpututil.IsKeyJustPressed(ebiten.KeyQ) {
+		g.ReturnToTitle()
+	}
+	
+	return nil
+}
@@ -0,0 +1,139 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+
+	"game/internal/config"
+	"game/internal/i18n"
+	"game/internal/pattern"
+)
+
+// practiceOption は練習モードの設定画面の1項目
+type practiceOption struct {
+	label  string                        // 項目名のメッセージのキー
+	value  func(r *RunConfig) string     // 現在の値の表示
+	change func(r *RunConfig, delta int) // 左右キーで値を切り替える（delta は -1 か +1）
+}
+
+// practiceOptions は練習モードの設定画面の項目（登録した順に表示される）
+var practiceOptions []practiceOption
+
+// registerPracticeOption は練習モードの設定画面に項目を追加する
+func registerPracticeOption(o practiceOption) {
+	practiceOptions = append(practiceOptions, o)
+}
+
+func init() {
+	registerPracticeOption(practiceOption{
+		label: "practice.preset",
+		value: func(r *RunConfig) string {
+			return i18n.T("preset." + config.Current().Preset(r.Preset).Name)
+		},
+		change: func(r *RunConfig, delta int) {
+			r.Preset = config.DifficultyKind(wrap(int(r.Preset)+delta, config.DifficultyCount))
+		},
+	})
+	registerPracticeOption(practiceOption{
+		label: "practice.level",
+		value: func(r *RunConfig) string { return fmt.Sprintf("%d", r.startLevel()) },
+		change: func(r *RunConfig, delta int) {
+			r.StartLevel = wrap(r.startLevel()-1+delta, config.PracticeMaxLevel) + 1
+		},
+	})
+	registerPracticeOption(practiceOption{
+		label: "practice.pattern",
+		value: func(r *RunConfig) string {
+			if r.Pattern == "" {
+				return i18n.T("practice.pattern_curve")
+			}
+			return r.Pattern
+		},
+		change: func(r *RunConfig, delta int) {
+			// 先頭は「難易度カーブに従う」（空の名前）
+			choices := append([]string{""}, pattern.Names()...)
+			current := 0
+			for i, name := range choices {
+				if name == r.Pattern {
+					current = i
+				}
+			}
+			r.Pattern = choices[wrap(current+delta, len(choices))]
+		},
+	})
+	registerPracticeOption(practiceOption{
+		label:  "practice.shields",
+		value:  func(r *RunConfig) string { return onOff(!r.NoShields) },
+		change: func(r *RunConfig, delta int) { r.NoShields = !r.NoShields },
+	})
+	registerPracticeOption(practiceOption{
+		label:  "practice.infinite_bombs",
+		value:  func(r *RunConfig) string { return onOff(r.InfiniteBombs) },
+		change: func(r *RunConfig, delta int) { r.InfiniteBombs = !r.InfiniteBombs },
+	})
+	registerPracticeOption(practiceOption{
+		label:  "practice.infinite_lives",
+		value:  func(r *RunConfig) string { return onOff(r.InfiniteLives) },
+		change: func(r *RunConfig, delta int) { r.InfiniteLives = !r.InfiniteLives },
+	})
+}
+
+// wrap は i を 0〜n-1 の範囲に折り返す
+func wrap(i, n int) int {
+	return (i%n + n) % n
+}
+
+// PracticeEntries は練習モードの設定画面に表示する項目の一覧を返す
+func (g *Game) PracticeEntries() []SettingsEntry {
+	entries := make([]SettingsEntry, len(practiceOptions))
+	for i, o := range practiceOptions {
+		entries[i] = SettingsEntry{Label: i18n.T(o.label), Value: o.value(&g.PracticeRun)}
+	}
+	return entries
+}
+
+// OpenPractice は練習モードの設定画面を開く（初めて開くときはタイトル画面で選択中の難易度から始める）
+func (g *Game) OpenPractice() {
+	if !g.PracticeRun.Practice {
+		g.PracticeRun = RunConfig{Preset: g.TitleSelection, Practice: true}
+	}
+	g.PracticeSelection = 0
+	g.Scene = ScenePractice
+}
+
+// updatePractice は練習モードの設定画面の更新処理
+func (g *Game) updatePractice() error {
+	count := len(practiceOptions)
+
+	// 上下キーで項目を選択
+	if inpututil.IsKeyJustPressed(ebiten.KeyUp) {
+		g.PracticeSelection = (g.PracticeSelection + count - 1) % count
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyDown) {
+		g.PracticeSelection = (g.PracticeSelection + 1) % count
+	}
+
+	// 左右キーで値を切り替え
+	selected := practiceOptions[g.PracticeSelection]
+	if inpututil.IsKeyJustPressed(ebiten.KeyLeft) {
+		selected.change(&g.PracticeRun, -1)
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyRight) {
+		selected.change(&g.PracticeRun, 1)
+	}
+
+	// スペースキーまたはEnterキーで練習を開始
+	if inpututil.IsKeyJustPressed(ebiten.KeySpace) || inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+		g.StartRun(g.PracticeRun)
+		return nil
+	}
+
+	// EscキーまたはRキーでタイトル画面に戻る
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) || inpututil.IsKeyJustPressed(ebiten.KeyR) {
+		g.Scene = SceneTitle
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package game
+
+import (
+	"sort"
+
+	"game/internal/config"
+)
+
+// ScoreRecord はランキングに記録される1回分の結果
+type ScoreRecord struct {
+	Time   float64               // 生存時間（秒）
+	Score  int                   // ボムなどで得た得点
+	Preset config.DifficultyKind // プレイした難易度プリセット
+}
+
+// Rankings は難易度プリセットごとに分けたランキング
+type Rankings map[config.DifficultyKind][]ScoreRecord
+
+// Add は結果を該当する難易度のランキングに追加する
+func (r Rankings) Add(record ScoreRecord) {
+	records := append(r[record.Preset], record)
+	
+	// 生存時間の降順にソート
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Time > records[j].Time
+	})
+	
+	// 上位スコアだけを保持
+	if len(records) > config.MaxRankingScores {
+		records = records[:config.MaxRankingScores]
+	}
+	r[record.Preset] = records
+}
+
+// Top は指定した難易度のランキングを返す
+func (r Rankings) Top(preset config.DifficultyKind) []ScoreRecord {
+	return r[preset]
+}
+
+// Best は指定した難易度の最高記録を返す（記録がなければokはfalse）
+func (r Rankings) Best(preset config.DifficultyKind) (record ScoreRecord, ok bool) {
+	records := r[preset]
+	if len(records) == 0 {
+		return ScoreRecord{}, false
+	}
+	return records[0], true
+}
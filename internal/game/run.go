@@ -0,0 +1,65 @@
+package game
+
+import "game/internal/config"
+
+// RunConfig は1回分のプレイの始め方（NewGame と StartRun に渡す）
+// ゼロ値の項目は通常のプレイと同じ振る舞いになる
+type RunConfig struct {
+	Preset           config.DifficultyKind // 難易度プリセット
+	Seed             int64                 // 乱数のシード（0ならプレイのたびに現在時刻から作る）
+	StartLevel       int                   // 開始時の難易度レベル（0ならプリセットの開始レベル）
+	Pattern          string                // 発射する弾幕パターン（空なら難易度カーブに従って選ぶ）
+	NoShields        bool                  // シールドアイテムを出現させない
+	InfiniteBombs    bool                  // ボムのクールダウンをなくす
+	InfiniteLives    bool                  // 被弾してもゲームオーバーにせず、ミスとして数える
+	Practice         bool                  // 練習モード（ランキングに載せず、実績も解除しない）
+	Unranked         bool                  // ランキングに載せない（その日2回目以降のデイリーチャレンジなど）
+	Daily            string                // デイリーチャレンジの日付（空なら通常のプレイ）
+	Debugged         bool                  // デバッグ用の操作でプレイの内容を変えた（ランキングに載せず、実績も解除しない）
+	Assist           bool                  // アシストモード（StartRun がタイトル画面の設定から写す）
+	BulletSpeedScale float64               // 弾速の倍率（0なら1倍）
+	GrazeMultiplier  int                   // グレイズ1回あたりに数える回数（0なら1回）
+}
+
+// NormalRun は難易度プリセットだけを指定した通常のプレイの始め方を返す
+func NormalRun(preset config.DifficultyKind) RunConfig {
+	return RunConfig{Preset: preset}
+}
+
+// Ranked はランキングに記録するプレイかどうかを返す
+// ランキング・デイリーチャレンジの記録・通算の統計・実績の対象はすべてこの値で決める
+func (r RunConfig) Ranked() bool {
+	return !r.Practice && !r.Unranked && !r.Debugged && !r.Assist
+}
+
+// bulletSpeedScale は弾速の倍率を返す
+func (r RunConfig) bulletSpeedScale() float64 {
+	if r.BulletSpeedScale > 0 {
+		return r.BulletSpeedScale
+	}
+	return 1.0
+}
+
+// grazeMultiplier はグレイズ1回あたりに数える回数を返す
+func (r RunConfig) grazeMultiplier() int {
+	if r.GrazeMultiplier > 0 {
+		return r.GrazeMultiplier
+	}
+	return 1
+}
+
+// startLevel は開始時の難易度レベルを返す
+func (r RunConfig) startLevel() int {
+	if r.StartLevel > 0 {
+		return r.StartLevel
+	}
+	return config.Current().Preset(r.Preset).StartLevel
+}
+
+// seed は乱数のシードを返す（指定がなければ現在時刻から作る）
+func (r RunConfig) seed() int64 {
+	if r.Seed != 0 {
+		return r.Seed
+	}
+	return newSeed()
+}
@@ -0,0 +1,142 @@
+package game
+
+import (
+	"fmt"
+	"log/slog"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+
+	"game/internal/audio"
+	"game/internal/config"
+	"game/internal/i18n"
+	"game/internal/theme"
+)
+
+// SettingsEntry は設定画面の1項目の表示内容（表示言語に合わせた文字列）
+type SettingsEntry struct {
+	Label string
+	Value string
+}
+
+// setting は設定画面の1項目
+type setting struct {
+	label  string                   // 項目名のメッセージのキー
+	value  func(g *Game) string     // 現在の値の表示
+	change func(g *Game, delta int) // 左右キーで値を切り替える（delta は -1 か +1）
+}
+
+// settings は設定画面の項目（登録した順に表示される）
+var settings []setting
+
+// registerSetting は設定画面に項目を追加する
+func registerSetting(s setting) {
+	settings = append(settings, s)
+}
+
+func init() {
+	registerSetting(setting{
+		label: "settings.language",
+		value: func(g *Game) string {
+			return i18n.T("language." + string(i18n.Current()))
+		},
+		change: func(g *Game, delta int) {
+			g.SetLocale(i18n.Next(i18n.Current(), delta))
+		},
+	})
+	registerSetting(setting{
+		label: "settings.theme",
+		value: func(g *Game) string {
+			return i18n.T("theme." + theme.Current().ID)
+		},
+		change: func(g *Game, delta int) {
+			theme.Next(delta)
+		},
+	})
+	registerSetting(setting{
+		label: "settings.shake",
+		value: func(g *Game) string { return onOff(g.Feedback.ShakeEnabled) },
+		change: func(g *Game, delta int) {
+			g.Feedback.ShakeEnabled = !g.Feedback.ShakeEnabled
+		},
+	})
+	registerSetting(setting{
+		label: "settings.flash",
+		value: func(g *Game) string { return onOff(g.Feedback.FlashEnabled) },
+		change: func(g *Game, delta int) {
+			g.Feedback.FlashEnabled = !g.Feedback.FlashEnabled
+		},
+	})
+	registerVolumeSetting("settings.master_volume", audio.ChannelMaster)
+	registerVolumeSetting("settings.music_volume", audio.ChannelMusic)
+	registerVolumeSetting("settings.sfx_volume", audio.ChannelSFX)
+}
+
+// registerVolumeSetting は音量の系統ごとの設定項目を追加する
+func registerVolumeSetting(label string, ch audio.Channel) {
+	registerSetting(setting{
+		label: label,
+		value: func(g *Game) string {
+			return fmt.Sprintf("%d%%", int(math.Round(g.Audio.Volume(ch)*100)))
+		},
+		change: func(g *Game, delta int) {
+			g.Audio.SetVolume(ch, g.Audio.Volume(ch)+float64(delta)*config.VolumeStep)
+		},
+	})
+}
+
+// onOff はオン/オフの設定値の表示を返す
+func onOff(enabled bool) string {
+	if enabled {
+		return i18n.T("settings.on")
+	}
+	return i18n.T("settings.off")
+}
+
+// SettingsEntries は設定画面に表示する項目の一覧を返す
+func (g *Game) SettingsEntries() []SettingsEntry {
+	entries := make([]SettingsEntry, len(settings))
+	for i, s := range settings {
+		entries[i] = SettingsEntry{Label: i18n.T(s.label), Value: s.value(g)}
+	}
+	return entries
+}
+
+// SetLocale は表示言語を切り替え、ウィンドウのタイトルにも反映する
+func (g *Game) SetLocale(l i18n.Locale) {
+	if err := i18n.Set(l); err != nil {
+		slog.Warn("表示言語を切り替えられません", "err", err)
+		return
+	}
+	ebiten.SetWindowTitle(i18n.T("window.title"))
+}
+
+// updateSettings は設定画面の更新処理
+func (g *Game) updateSettings() error {
+	count := len(settings)
+	
+	// 上下キーで項目を選択
+	if inpututil.IsKeyJustPressed(ebiten.KeyUp) {
+		g.SettingsSelection = (g.SettingsSelection + count - 1) % count
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyDown) {
+		g.SettingsSelection = (g.SettingsSelection + 1) % count
+	}
+	
+	// 左右キー（Enterキーは次の値）で値を切り替え
+	selected := settings[g.SettingsSelection]
+	if inpututil.IsKeyJustPressed(ebiten.KeyLeft) {
+		selected.change(g, -1)
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyRight) || inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+		selected.change(g, 1)
+	}
+	
+	// EscキーまたはSキーでタイトル画面に戻る
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) || inpututil.IsKeyJustPressed(ebiten.KeyS) {
+		g.Scene = SceneTitle
+	}
+	
+	return nil
+}
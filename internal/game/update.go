@@ -1,66 +1,135 @@
 package game
 
 import (
-	"log"
 	"math"
-	"math/rand"
-	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	
+	"game/internal/audio"
 	"game/internal/config"
 	"game/internal/entity"
+	"game/internal/event"
 )
 
 // Update はゲームの状態を更新する
 func (g *Game) Update() error {
-	// ゲームオーバー時のリスタート処理とアニメーション
-	if g.GameOver {
+	// 画面表示の切り替えはどの画面でも受け付ける
+	g.updateDisplayKeys()
+	
+	// 画面に合ったBGMに切り替え、効果音の片付けとクロスフェードを進める
+	g.updateMusic()
+	g.Audio.Update(config.DeltaTime)
+	
+	switch g.Scene {
+	case SceneTitle:
+		// タイトル画面での難易度選択
+		return g.updateTitle()
+	case SceneGameOver:
+		// ゲームオーバー時のリスタート処理とアニメーション
 		return g.updateGameOver()
+	case SceneSettings:
+		// 設定画面での項目の切り替え
+		return g.updateSettings()
+	case ScenePaused:
+		// 一時停止中は再開かタイトルへ戻る操作だけを受け付ける
+		return g.updatePaused()
+	case ScenePractice:
+		// 練習モードの設定画面での項目の切り替え
+		return g.updatePractice()
+	}
+	
+	// PキーまたはEscキーで一時停止
+	if inpututil.IsKeyJustPressed(ebiten.KeyP) || inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		g.Pause()
+		return nil
+	}
+
+	// 時間の流れの倍率を決める（デバッグで時間を止めている間はコマ送りの指示があるときだけ進める）
+	if !g.advanceTime() {
+		return nil
+	}
+	dt := g.deltaTime()
+
+	// 画面の揺れとフラッシュを減衰させ、ヒットストップ中は進行を止める
+	if g.Feedback.Update(dt) {
+		return nil
 	}
+	g.Tick++
+	g.CollisionChecks = 0
 
-	// プレイヤーの位置をマウスカーソルに合わせる（画面内に制限）
-	x, y := ebiten.CursorPosition()
-	g.Player.X = math.Max(g.Player.Size, math.Min(float64(x), float64(config.ScreenWidth - g.Player.Size)))
-	g.Player.Y = math.Max(g.Player.Size, math.Min(float64(y), float64(config.ScreenHeight - g.Player.Size)))
+	// プレイヤーの位置をマウスカーソルに合わせる（ウィンドウ上の位置をプレイフィールド座標に変換し、画面内に制限）
+	x, y := g.Viewport.ScreenToPlayfield(ebiten.CursorPosition())
+	g.Player.X = math.Max(g.Player.Size, math.Min(x, float64(config.ScreenWidth) - g.Player.Size))
+	g.Player.Y = math.Max(g.Player.Size, math.Min(y, float64(config.ScreenHeight) - g.Player.Size))
 
 	// 経過時間を更新
-	g.CurrentTime = time.Since(g.StartTime).Seconds()
+	g.CurrentTime += dt
 	
 	// 爆発スキルのクールダウン更新
-	g.Player.UpdateBombCooldown(config.DeltaTime)
+	g.Player.UpdateBombCooldown(dt)
+	
+	// スロースキルのクールダウン更新
+	g.Player.UpdateSlowSkillCooldown(dt)
+	
+	// アイテム効果の残り時間を更新
+	g.Player.UpdateEffects(dt)
+	g.SlowMotionTime = math.Max(0, g.SlowMotionTime-dt)
+	
+	// Cキーでボムの種類を切り替え
+	if inpututil.IsKeyJustPressed(ebiten.KeyC) {
+		g.Player.NextBombKind()
+	}
 	
 	// Xキーで爆発スキルを発動
 	if inpututil.IsKeyJustPressed(ebiten.KeyX) {
 		if g.Player.UseBomb() {
 			// 爆発エフェクトを作成
-			g.Explosion = entity.NewExplosion(g.Player.X, g.Player.Y, g.Player.BombRadius)
-			
-			// 爆発範囲内の弾を消去
-			g.clearBulletsInExplosion()
+			g.Explosion = g.newExplosion()
+			g.emit(event.BombUsed{Kind: g.Player.BombKind, X: g.Player.X, Y: g.Player.Y})
 			
-			// 効果音を再生（将来的に実装）
-			// playSound("explosion.wav")
+			// ボム無制限のプレイではすぐに次のボムを使える
+			if g.Run.InfiniteBombs {
+				g.Player.RefillBomb()
+			}
 		}
 	}
 	
-	// 爆発エフェクトの更新
+	// Zキーでスロースキルを発動
+	if inpututil.IsKeyJustPressed(ebiten.KeyZ) {
+		if g.Player.UseSlowSkill() {
+			g.startSlowMotion(config.Current().SlowMotion.SkillDuration)
+		}
+	}
+	
+	// 爆発エフェクトの更新（広がっている間は毎フレーム弾を消去する）
 	if g.Explosion != nil && g.Explosion.Active {
-		g.Explosion.Update(config.DeltaTime)
+		g.Explosion.Update(dt)
+		g.clearBulletsInExplosion()
 	}
 	
 	// 難易度の更新
-	g.updateDifficulty()
+	g.updateDifficulty(dt)
+	
+	// アシストモードでは負荷に応じて弾幕の強さを調整
+	if g.Run.Assist {
+		g.Director.Update(dt, g.CurrentTime)
+	}
 	
 	// 弾の生成
 	g.updateBulletSpawn()
 	
-	// シールドアイテムの更新
-	g.updateShieldItem()
+	// アイテムの更新
+	g.updateItems()
+	
+	// 得点アイテムの更新
+	g.updateScoreItems()
 	
 	// スコアアニメーションの更新
 	g.updateScoreAnimations()
+	
+	// 粒子の更新
+	g.Particles.Update(dt)
 
 	// 弾の移動と衝突判定
 	g.updateBullets()
@@ -68,35 +137,72 @@ func (g *Game) Update() error {
 	return nil
 }
 
-// clearBulletsInExplosion は爆発範囲内の弾を消去する
+// newExplosion は選択中のボムの種類に応じた爆発を作成する
+func (g *Game) newExplosion() *entity.Explosion {
+	variant := config.Current().Bomb(g.Player.BombKind)
+	switch g.Player.BombKind {
+	case config.BombLineSweep:
+		// 画面上端から下端まで掃射する
+		return entity.NewExplosion(g.Player.BombKind, float64(config.ScreenWidth)/2, 0, config.ScreenHeight, variant.Duration)
+	case config.BombTimeStop:
+		// 波紋が画面全体を覆う大きさにする
+		reach := math.Hypot(config.ScreenWidth, config.ScreenHeight)
+		return entity.NewExplosion(g.Player.BombKind, g.Player.X, g.Player.Y, reach, variant.Duration)
+	default:
+		return entity.NewExplosion(g.Player.BombKind, g.Player.X, g.Player.Y, g.Player.BombRadius, variant.Duration)
+	}
+}
+
+// clearBulletsInExplosion は現在の爆発範囲内の弾を消去し、得点アイテムに変える
 func (g *Game) clearBulletsInExplosion() {
 	if g.Explosion == nil {
 		return
 	}
 	
 	newBullets := make([]*entity.Bullet, 0, len(g.Bullets))
-	clearedCount := 0
+	var cleared []*entity.Bullet
 	
 	for _, b := range g.Bullets {
-		// 弾と爆発中心との距離を計算
-		dx := b.X - g.Explosion.X
-		dy := b.Y - g.Explosion.Y
-		distance := math.Sqrt(dx*dx + dy*dy)
-		
 		// 爆発範囲外の弾だけを残す
-		if distance > g.Player.BombRadius {
+		if !g.Explosion.Contains(b.X, b.Y, b.Size) {
 			newBullets = append(newBullets, b)
 		} else {
-			clearedCount++
+			cleared = append(cleared, b)
+			scoreItem := config.Current().ScoreItem
+			g.ScoreItems = append(g.ScoreItems, entity.NewScoreItem(b.X, b.Y, scoreItem.Size, scoreItem.Value, scoreItem.SpeedMax))
 		}
 	}
 	
 	g.Bullets = newBullets
-	log.Printf("爆発スキルで%d個の弾を消去しました", clearedCount)
+	if len(cleared) > 0 {
+		g.emit(event.BulletCleared{Bullets: cleared})
+	}
+}
+
+// updateScoreItems は得点アイテムを移動させ、プレイヤーに届いたものを得点にする
+func (g *Game) updateScoreItems() {
+	newItems := g.ScoreItems[:0]
+	for _, item := range g.ScoreItems {
+		item.Update(g.Player.X, g.Player.Y, g.stepScale)
+		
+		g.CollisionChecks++
+		if item.CollidesWith(g.Player.X, g.Player.Y, g.Player.Size) {
+			g.Score += item.Value
+			continue
+		}
+		
+		newItems = append(newItems, item)
+	}
+	g.ScoreItems = newItems
 }
 
 // updateGameOver はゲームオーバー時の更新処理
 func (g *Game) updateGameOver() error {
+	// やられた瞬間のヒットストップ中はアニメーションを止める
+	if g.Feedback.Update(config.DeltaTime) {
+		return nil
+	}
+	
 	// ゲームオーバーアニメーションの更新
 	if g.GameOverAlpha < 0.8 {
 		g.GameOverAlpha += 0.02
@@ -113,58 +219,116 @@ func (g *Game) updateGameOver() error {
 		g.RankingAppear += 0.03
 	}
 	
+	// やられたときの爆発の粒子はゲームオーバー画面でも動かす
+	g.Particles.Update(config.DeltaTime)
+	
 	// リスタート処理
 	if inpututil.IsKeyJustPressed(ebiten.KeySpace) {
 		g.Reset()
+	} else if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		g.ReturnToTitle()
 	}
 	
 	return nil
 }
 
-// updateDifficulty は難易度を更新する
-func (g *Game) updateDifficulty() {
-	// 6秒ごとに難易度を上げる
-	if time.Since(g.LastDifficultyIncrease).Seconds() > 6.0 {
-		g.Difficulty++
-		g.LastDifficultyIncrease = time.Now()
-		
-		// デバッグ用に難易度上昇を表示
-		log.Printf("難易度上昇: レベル %d", g.Difficulty)
+// updateMusic は画面に合ったBGMを流す（タイトル画面と設定画面ではタイトルの曲、それ以外はプレイ中の曲）
+func (g *Game) updateMusic() {
+	switch g.Scene {
+	case SceneTitle, SceneSettings, ScenePractice:
+		g.Audio.PlayMusic(audio.TrackTitle)
+	default:
+		g.Audio.PlayMusic(audio.TrackGame)
 	}
 }
 
-// updateBulletSpawn は弾の生成を更新する
-func (g *Game) updateBulletSpawn() {
-	// 難易度に応じて弾の発生頻度を調整
-	bulletSpawnInterval := 1.0 / float64(config.BulletSpawnRate)
-	if time.Since(g.LastBulletAdd).Seconds() > bulletSpawnInterval {
-		// 難易度に応じて複数の弾を発射
-		bulletsToAdd := g.Difficulty
-		for i := 0; i < bulletsToAdd; i++ {
-			g.addRandomBullet()
-		}
-		g.LastBulletAdd = time.Now()
+// updateDisplayKeys はフルスクリーンや拡大方法の切り替えを処理する
+func (g *Game) updateDisplayKeys() {
+	// F11キーでフルスクリーンを切り替え
+	if inpututil.IsKeyJustPressed(ebiten.KeyF11) {
+		ebiten.SetFullscreen(!ebiten.IsFullscreen())
+	}
+	
+	// F10キーで縦横比（4:3 / サイドパネル付き16:9）を切り替え
+	if inpututil.IsKeyJustPressed(ebiten.KeyF10) {
+		g.Viewport.NextAspect()
+	}
+	
+	// F9キーで整数倍拡大を切り替え
+	if inpututil.IsKeyJustPressed(ebiten.KeyF9) {
+		g.Viewport.IntegerScale = !g.Viewport.IntegerScale
 	}
 }
 
-// updateShieldItem はシールドアイテムを更新する
-func (g *Game) updateShieldItem() {
-	// シールドアイテムの生成（ランダムに）
-	if !g.ShieldItem.Active && rand.Float64() < config.ShieldSpawnRate {
-		g.ShieldItem.Spawn(config.ScreenWidth, config.ScreenHeight)
+// updateTitle はタイトル画面の更新処理
+func (g *Game) updateTitle() error {
+	presetCount := config.DifficultyKind(config.DifficultyCount)
+	
+	// 上下キーで難易度を選択
+	if inpututil.IsKeyJustPressed(ebiten.KeyUp) {
+		g.TitleSelection = (g.TitleSelection + presetCount - 1) % presetCount
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyDown) {
+		g.TitleSelection = (g.TitleSelection + 1) % presetCount
 	}
 	
-	// シールドアイテムのアニメーション更新
-	g.ShieldItem.Update()
+	// Aキーでアシストモード（動的難易度調整）を切り替え
+	if inpututil.IsKeyJustPressed(ebiten.KeyA) {
+		g.Assist = !g.Assist
+	}
 	
-	// シールドアイテムとプレイヤーの衝突判定
-	if g.ShieldItem.CollidesWith(g.Player.X, g.Player.Y, g.Player.Size) {
-		// シールドを獲得
-		g.Player.AddShield(config.ShieldDurability)
-		g.ShieldItem.Deactivate()
-		
-		// デバッグ用にシールド獲得を表示
-		log.Printf("シールド獲得！ 耐久値: %d", g.Player.Shield)
+	// Sキーで設定画面を開く
+	if inpututil.IsKeyJustPressed(ebiten.KeyS) {
+		g.SettingsSelection = 0
+		g.Scene = SceneSettings
+		return nil
+	}
+	
+	// Rキーで練習モードの設定画面を開く
+	if inpututil.IsKeyJustPressed(ebiten.KeyR) {
+		g.OpenPractice()
+		return nil
+	}
+	
+	// Dキーで今日のデイリーチャレンジを開始
+	if inpututil.IsKeyJustPressed(ebiten.KeyD) {
+		g.StartDaily()
+		return nil
+	}
+	
+	// スペースキーまたはEnterキーで開始
+	if inpututil.IsKeyJustPressed(ebiten.KeySpace) || inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+		g.Start(g.TitleSelection)
+	}
+	
+	return nil
+}
+
+// updateDifficulty は難易度を dt 秒分進めて更新する
+func (g *Game) updateDifficulty(dt float64) {
+	// 難易度プリセットの間隔ごとに難易度を上げる
+	g.DifficultyElapsed += dt
+	if g.DifficultyElapsed > g.PresetSettings().LevelUpInterval {
+		g.Difficulty++
+		g.DifficultyElapsed = 0
+		g.emit(event.DifficultyUp{Level: g.Difficulty})
+	}
+}
+
+// updateBulletSpawn は弾の生成を更新する
+func (g *Game) updateBulletSpawn() {
+	// 難易度カーブに従って発射間隔・発射数・弾幕パターンを決める（スローモーション中は発生も遅くなる）
+	// アシストモードでは難易度係数に応じて発射間隔を伸縮する
+	params := g.Curve.At(g.Difficulty)
+	g.BulletSpawnElapsed += config.DeltaTime * g.bulletTimeScale()
+	if g.BulletSpawnElapsed > params.SpawnInterval/g.assistFactor() {
+		// 弾幕パターンを固定したプレイでは常にそのパターンを発射する
+		name := g.Run.Pattern
+		if name == "" {
+			name = params.PickPattern(g.Rand)
+		}
+		g.spawnPattern(name, params.BulletsPerWave)
+		g.BulletSpawnElapsed = 0
 	}
 }
 
@@ -172,7 +336,7 @@ func (g *Game) updateShieldItem() {
 func (g *Game) updateScoreAnimations() {
 	newScoreAnims := make([]*entity.ScoreAnimation, 0)
 	for _, anim := range g.ScoreAnimations {
-		anim.Update(config.DeltaTime)
+		anim.Update(g.deltaTime())
 		
 		if anim.IsActive() {
 			newScoreAnims = append(newScoreAnims, anim)
@@ -183,38 +347,64 @@ func (g *Game) updateScoreAnimations() {
 
 // updateBullets は弾の移動と衝突判定を更新する
 func (g *Game) updateBullets() {
+	timeScale := g.bulletTimeScale()
 	newBullets := make([]*entity.Bullet, 0, len(g.Bullets))
 	for _, b := range g.Bullets {
-		// 弾を移動
-		b.Update()
+		// 弾を移動（時間停止中は止まり、スローモーション中は遅くなる）
+		// 誘導弾はプレイヤーの方へ曲げてから動かす
+		b.Steer(g.Player.X, g.Player.Y, timeScale)
+		b.Update(timeScale)
 		
 		// 画面外に出た弾は削除
 		if b.IsOutOfScreen(config.ScreenWidth, config.ScreenHeight, 100) {
 			continue
 		}
 		
+		// 分裂弾は時間が来たら小さな弾に分かれる（分かれた弾は次のフレームから動く）
+		if b.ShouldSplit() {
+			newBullets = append(newBullets, b.Split()...)
+			continue
+		}
+		
 		// プレイヤーとの衝突判定
+		g.CollisionChecks++
 		if b.CollidesWith(g.Player.X, g.Player.Y, g.Player.Size) {
+			// 無敵中（デバッグの無敵モードを含む）は弾がすり抜ける
+			if g.Player.IsInvincible() || g.GodMode {
+				newBullets = append(newBullets, b)
+				continue
+			}
+			
 			// シールドがある場合
 			if g.Player.HasShield() {
 				g.Player.ReduceShield()
-				log.Printf("シールドが弾を防いだ！ 残り耐久値: %d", g.Player.Shield)
+				g.emit(event.ShieldHit{X: b.X, Y: b.Y, Remaining: g.Player.Shield})
 				continue // この弾は消える
+			} else if g.Run.InfiniteLives {
+				// 残機無制限のプレイではミスとして数えて続ける
+				g.miss()
+				continue
 			} else {
 				// シールドがない場合、ゲームオーバー
-				g.GameOver = true
-				
-				// スコアを記録
-				g.AddScore(g.CurrentTime)
-				
+				g.endRun()
 				break
 			}
 		}
 		
+		// 当たらずにかすめた弾はグレイズとして1回だけ数える
+		if !b.Grazed {
+			g.CollisionChecks++
+		}
+		if !b.Grazed && b.Grazes(g.Player.X, g.Player.Y, g.Player.Size, config.Current().Graze.Margin) {
+			b.Grazed = true
+			g.Grazes += g.Run.grazeMultiplier()
+			g.emit(event.Grazed{X: b.X, Y: b.Y, Total: g.Grazes})
+		}
+		
 		newBullets = append(newBullets, b)
 	}
 	
-	if !g.GameOver {
+	if g.Scene != SceneGameOver {
 		g.Bullets = newBullets
 	}
 }
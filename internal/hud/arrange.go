@@ -0,0 +1,69 @@
+package hud
+
+import "image"
+
+// Placement は配置が決まったウィジェット
+type Placement struct {
+	Widget Widget
+	Region Region          // 実際に置かれた領域（サイドパネルがなければ overlay）
+	Rect   image.Rectangle // 領域と同じ座標系でのウィジェットの範囲
+}
+
+// Arrange はレイアウトに従ってウィジェットの位置を決める
+// areas には領域ごとの範囲を渡し、空のサイドパネルに置くウィジェットはプレイフィールド上のFallbackの位置に回される
+// size はウィジェットの大きさを返し、幅か高さが0のウィジェット（表示するものがないもの）は場所を取らない
+func (l *Layout) Arrange(areas map[Region]image.Rectangle, size func(name string) (int, int)) []Placement {
+	// 領域と寄せる位置ごとに、次のウィジェットを置くまでの積み重ねの量を記録する
+	type slot struct {
+		region Region
+		anchor Anchor
+	}
+	stacked := make(map[slot]int)
+
+	placements := make([]Placement, 0, len(l.Widgets))
+	for _, w := range l.Widgets {
+		region, anchor := w.Region, w.Anchor
+		if area := areas[region]; area.Empty() {
+			region = RegionOverlay
+			if w.Fallback != "" {
+				anchor = w.Fallback
+			}
+		}
+		area := areas[region]
+
+		width, height := size(w.Name)
+		if width <= 0 || height <= 0 {
+			continue
+		}
+
+		s := slot{region, anchor}
+		offset := stacked[s]
+		stacked[s] += height + l.Spacing
+
+		var x, y int
+		switch anchor {
+		case AnchorTopLeft, AnchorBottomLeft:
+			x = area.Min.X + l.Margin
+		case AnchorTopRight, AnchorBottomRight:
+			x = area.Max.X - l.Margin - width
+		default:
+			x = area.Min.X + (area.Dx()-width)/2
+		}
+		switch anchor {
+		case AnchorBottomLeft, AnchorBottom, AnchorBottomRight:
+			// 下寄せは下から上へ積み重ねる
+			y = area.Max.Y - l.Margin - offset - height
+		default:
+			y = area.Min.Y + l.Margin + offset
+		}
+
+		x += w.OffsetX
+		y += w.OffsetY
+		placements = append(placements, Placement{
+			Widget: w,
+			Region: region,
+			Rect:   image.Rect(x, y, x+width, y+height),
+		})
+	}
+	return placements
+}
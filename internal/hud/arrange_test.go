@@ -0,0 +1,81 @@
+package hud
+
+import (
+	"image"
+	"testing"
+)
+
+func TestArrange(t *testing.T) {
+	l := &Layout{
+		Margin:  10,
+		Spacing: 4,
+		Widgets: []Widget{
+			{Name: WidgetScore, Region: RegionOverlay, Anchor: AnchorTopLeft},
+			{Name: WidgetTime, Region: RegionOverlay, Anchor: AnchorTopLeft},
+			{Name: WidgetFPS, Region: RegionOverlay, Anchor: AnchorTopRight, OffsetX: -2, OffsetY: 3},
+			{Name: WidgetLives, Region: RegionOverlay, Anchor: AnchorBottom},
+			{Name: WidgetShield, Region: RegionOverlay, Anchor: AnchorBottom},
+			{Name: WidgetGraze, Region: RegionRight, Anchor: AnchorTopLeft, Fallback: AnchorBottomRight},
+			{Name: WidgetEffects, Region: RegionOverlay, Anchor: AnchorTopLeft},
+		},
+	}
+	sizes := map[string]image.Point{
+		WidgetScore:   {100, 20},
+		WidgetTime:    {80, 20},
+		WidgetFPS:     {50, 16},
+		WidgetLives:   {60, 20},
+		WidgetShield:  {40, 20},
+		WidgetGraze:   {70, 20},
+		WidgetEffects: {0, 0}, // 表示するものがない
+	}
+	size := func(name string) (int, int) { return sizes[name].X, sizes[name].Y }
+
+	t.Run("サイドパネルあり", func(t *testing.T) {
+		areas := map[Region]image.Rectangle{
+			RegionOverlay: image.Rect(100, 0, 500, 300),
+			RegionRight:   image.Rect(500, 0, 600, 300),
+		}
+		want := map[string]Placement{
+			WidgetScore:  {Region: RegionOverlay, Rect: image.Rect(110, 10, 210, 30)},
+			WidgetTime:   {Region: RegionOverlay, Rect: image.Rect(110, 34, 190, 54)}, // 下に積み重ねる
+			WidgetFPS:    {Region: RegionOverlay, Rect: image.Rect(438, 13, 488, 29)},
+			WidgetLives:  {Region: RegionOverlay, Rect: image.Rect(270, 270, 330, 290)},
+			WidgetShield: {Region: RegionOverlay, Rect: image.Rect(280, 246, 320, 266)}, // 下寄せは上に積み重ねる
+			WidgetGraze:  {Region: RegionRight, Rect: image.Rect(510, 10, 580, 30)},
+		}
+		checkPlacements(t, l.Arrange(areas, size), want)
+	})
+
+	t.Run("サイドパネルなし", func(t *testing.T) {
+		areas := map[Region]image.Rectangle{
+			RegionOverlay: image.Rect(0, 0, 400, 300),
+		}
+		want := map[string]Placement{
+			WidgetScore:  {Region: RegionOverlay, Rect: image.Rect(10, 10, 110, 30)},
+			WidgetTime:   {Region: RegionOverlay, Rect: image.Rect(10, 34, 90, 54)},
+			WidgetFPS:    {Region: RegionOverlay, Rect: image.Rect(338, 13, 388, 29)},
+			WidgetLives:  {Region: RegionOverlay, Rect: image.Rect(170, 270, 230, 290)},
+			WidgetShield: {Region: RegionOverlay, Rect: image.Rect(180, 246, 220, 266)},
+			WidgetGraze:  {Region: RegionOverlay, Rect: image.Rect(320, 270, 390, 290)}, // Fallback の位置に回す
+		}
+		checkPlacements(t, l.Arrange(areas, size), want)
+	})
+}
+
+// checkPlacements は配置がウィジェットごとに want と一致するかどうかを調べる
+func checkPlacements(t *testing.T, placements []Placement, want map[string]Placement) {
+	t.Helper()
+	if len(placements) != len(want) {
+		t.Errorf("%d 個のウィジェットが配置されました、want %d", len(placements), len(want))
+	}
+	for _, p := range placements {
+		w, ok := want[p.Widget.Name]
+		if !ok {
+			t.Errorf("%s は配置されないはずです", p.Widget.Name)
+			continue
+		}
+		if p.Region != w.Region || p.Rect != w.Rect {
+			t.Errorf("%s: %s %v, want %s %v", p.Widget.Name, p.Region, p.Rect, w.Region, w.Rect)
+		}
+	}
+}
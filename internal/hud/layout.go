@@ -0,0 +1,179 @@
+package hud
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// defaultLayoutJSON は組み込みのHUDレイアウト
+//
+//go:embed layout.json
+var defaultLayoutJSON []byte
+
+// HUDに置けるウィジェットの種類
+const (
+	WidgetScore      = "score"      // ボムで得た得点
+	WidgetHiScore    = "hiscore"    // 選択中の難易度の最高記録
+	WidgetTime       = "time"       // 経過時間
+	WidgetDifficulty = "difficulty" // 難易度プリセットとレベル（休憩中・アシストの表示を含む）
+	WidgetBomb       = "bomb"       // ボムの種類とクールダウンのゲージ
+	WidgetSlow       = "slow"       // スローモーションの残り時間とスロースキルのゲージ
+	WidgetShield     = "shield"     // シールドの残り耐久値
+	WidgetGraze      = "graze"      // グレイズ回数
+	WidgetLives      = "lives"      // 残機
+	WidgetEffects    = "effects"    // アイテム効果の残り時間
+	WidgetFPS        = "fps"        // フレームレート
+)
+
+// Widgets はHUDに置けるウィジェットの種類の一覧
+var Widgets = []string{
+	WidgetScore, WidgetHiScore, WidgetTime, WidgetDifficulty, WidgetBomb, WidgetSlow,
+	WidgetShield, WidgetGraze, WidgetLives, WidgetEffects, WidgetFPS,
+}
+
+// Region はウィジェットを置く領域
+type Region string
+
+// ウィジェットを置く領域
+const (
+	RegionOverlay Region = "overlay" // プレイフィールドの上に重ねる
+	RegionLeft    Region = "left"    // 左のサイドパネル（16:9のみ）
+	RegionRight   Region = "right"   // 右のサイドパネル（16:9のみ）
+)
+
+// Anchor は領域内でウィジェットを寄せる位置
+type Anchor string
+
+// 領域内でウィジェットを寄せる位置
+const (
+	AnchorTopLeft     Anchor = "top-left"
+	AnchorTop         Anchor = "top"
+	AnchorTopRight    Anchor = "top-right"
+	AnchorBottomLeft  Anchor = "bottom-left"
+	AnchorBottom      Anchor = "bottom"
+	AnchorBottomRight Anchor = "bottom-right"
+)
+
+// Widget はレイアウト上の1つのウィジェットの配置
+// 同じ領域・同じ位置に寄せたウィジェットは書いた順に積み重ねられる
+type Widget struct {
+	Name     string `json:"widget"`
+	Region   Region `json:"region"`
+	Anchor   Anchor `json:"anchor"`
+	Fallback Anchor `json:"fallback,omitempty"` // サイドパネルがない場合にプレイフィールド上で寄せる位置（省略時はAnchorと同じ）
+	OffsetX  int    `json:"offset_x,omitempty"`
+	OffsetY  int    `json:"offset_y,omitempty"`
+}
+
+// Layout はHUDのレイアウト
+type Layout struct {
+	Margin  int      `json:"margin"`  // 領域の端からの余白
+	Spacing int      `json:"spacing"` // 積み重ねたウィジェットの間隔
+	Widgets []Widget `json:"widgets"`
+}
+
+// current は現在使われているHUDレイアウト
+var current *Layout
+
+func init() {
+	l, err := Parse(defaultLayoutJSON)
+	if err != nil {
+		panic(fmt.Sprintf("組み込みのHUDレイアウトが不正です: %v", err))
+	}
+	current = l
+}
+
+// Default は組み込みのHUDレイアウトを返す
+func Default() *Layout {
+	l, _ := Parse(defaultLayoutJSON)
+	return l
+}
+
+// Current は現在のHUDレイアウトを返す
+func Current() *Layout {
+	return current
+}
+
+// Use はHUDレイアウトを差し替える
+func Use(l *Layout) {
+	current = l
+}
+
+// Parse はJSONからHUDレイアウトを読み込み、検証する
+func Parse(data []byte) (*Layout, error) {
+	var l Layout
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&l); err != nil {
+		return nil, fmt.Errorf("HUDレイアウトを解析できません: %w", err)
+	}
+	if err := l.Validate(); err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
+// LoadFile はファイルからHUDレイアウトを読み込む
+func LoadFile(path string) (*Layout, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	l, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return l, nil
+}
+
+// Validate はレイアウトが正しいかどうかを検証し、問題をまとめて返す
+func (l *Layout) Validate() error {
+	var problems []string
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			problems = append(problems, fmt.Sprintf(format, args...))
+		}
+	}
+
+	check(l.Margin >= 0, "margin は0以上である必要があります（%d）", l.Margin)
+	check(l.Spacing >= 0, "spacing は0以上である必要があります（%d）", l.Spacing)
+
+	seen := make(map[string]bool)
+	for i, w := range l.Widgets {
+		check(isWidget(w.Name), "widgets[%d]: 不明なウィジェット %q", i, w.Name)
+		check(!seen[w.Name], "widgets[%d]: ウィジェット %q が重複しています", i, w.Name)
+		check(w.Region == RegionOverlay || w.Region == RegionLeft || w.Region == RegionRight,
+			"widgets[%d]: region は overlay / left / right のいずれかである必要があります（%q）", i, w.Region)
+		check(isAnchor(w.Anchor), "widgets[%d]: 不明な anchor %q", i, w.Anchor)
+		check(w.Fallback == "" || isAnchor(w.Fallback), "widgets[%d]: 不明な fallback %q", i, w.Fallback)
+		seen[w.Name] = true
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("HUDレイアウトが不正です:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+// isWidget は名前がウィジェットの種類のものかどうかを返す
+func isWidget(name string) bool {
+	for _, w := range Widgets {
+		if w == name {
+			return true
+		}
+	}
+	return false
+}
+
+// isAnchor は寄せる位置として正しいかどうかを返す
+func isAnchor(a Anchor) bool {
+	switch a {
+	case AnchorTopLeft, AnchorTop, AnchorTopRight, AnchorBottomLeft, AnchorBottom, AnchorBottomRight:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package hud
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDefaultLayout(t *testing.T) {
+	if err := Default().Validate(); err != nil {
+		t.Fatalf("組み込みのHUDレイアウトが不正です: %v", err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want string // エラーに含まれるはずの文（空なら正しいレイアウト）
+	}{
+		{"正しいレイアウト", `{"margin": 8, "widgets": [{"widget": "score", "region": "left", "anchor": "top", "fallback": "top-left"}]}`, ""},
+		{"負の余白", `{"margin": -1}`, "margin"},
+		{"負の間隔", `{"spacing": -1}`, "spacing"},
+		{"不明なウィジェット", `{"widgets": [{"widget": "mana", "region": "overlay", "anchor": "top"}]}`, "mana"},
+		{"重複したウィジェット", `{"widgets": [{"widget": "fps", "region": "overlay", "anchor": "top"}, {"widget": "fps", "region": "left", "anchor": "top"}]}`, "重複"},
+		{"不明な領域", `{"widgets": [{"widget": "fps", "region": "center", "anchor": "top"}]}`, "region"},
+		{"不明な寄せる位置", `{"widgets": [{"widget": "fps", "region": "overlay", "anchor": "middle"}]}`, "middle"},
+		{"不明な代わりの位置", `{"widgets": [{"widget": "fps", "region": "left", "anchor": "top", "fallback": "left"}]}`, "fallback"},
+		{"知らない項目", `{"padding": 4}`, "padding"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.json))
+			switch {
+			case tt.want == "" && err != nil:
+				t.Errorf("正しいレイアウトがエラーになりました: %v", err)
+			case tt.want != "" && err == nil:
+				t.Error("エラーになりませんでした")
+			case tt.want != "" && !strings.Contains(err.Error(), tt.want):
+				t.Errorf("エラーに %s が含まれていません: %v", tt.want, err)
+			}
+		})
+	}
+}
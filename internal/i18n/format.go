@@ -0,0 +1,47 @@
+package i18n
+
+import "fmt"
+
+// cardinal は数量の複数形の種類を返す（日本語は常に other）
+func cardinal(l Locale, n float64) string {
+	if l == LocaleEn && n == 1 {
+		return "one"
+	}
+	return "other"
+}
+
+// ordinal は序数の種類を返す（英語の 1st / 2nd / 3rd / 4th の区別）
+func ordinal(l Locale, n int) string {
+	if l != LocaleEn {
+		return "other"
+	}
+	switch {
+	case n%10 == 1 && n%100 != 11:
+		return "one"
+	case n%10 == 2 && n%100 != 12:
+		return "two"
+	case n%10 == 3 && n%100 != 13:
+		return "few"
+	}
+	return "other"
+}
+
+// Seconds は秒数を「12.34 seconds」「12.34秒」のように書式化する
+func Seconds(v float64) string {
+	return Plural("format.seconds", v, fmt.Sprintf("%.2f", v))
+}
+
+// ShortSeconds は秒数を「12.34 s」「12.34秒」のように短く書式化する
+func ShortSeconds(v float64) string {
+	return T("format.seconds_short", fmt.Sprintf("%.2f", v))
+}
+
+// Points は得点を「1 pt」「100 pts」「100点」のように書式化する
+func Points(n int) string {
+	return Plural("format.points", float64(n), n)
+}
+
+// Rank は順位を「1st」「1位」のように書式化する
+func Rank(n int) string {
+	return Ordinal("format.rank", n, n)
+}
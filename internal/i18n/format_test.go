@@ -0,0 +1,78 @@
+package i18n
+
+import "testing"
+
+// use は表示言語を切り替え、テストの終わりに元に戻す
+func use(t *testing.T, l Locale) {
+	t.Helper()
+	prev := Current()
+	if err := Set(l); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { current = prev })
+}
+
+func TestOrdinal(t *testing.T) {
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{1, "one"}, {2, "two"}, {3, "few"}, {4, "other"},
+		{11, "other"}, {12, "other"}, {13, "other"},
+		{21, "one"}, {22, "two"}, {23, "few"},
+		{101, "one"}, {111, "other"}, {112, "other"}, {113, "other"},
+	}
+	for _, tt := range tests {
+		if got := ordinal(LocaleEn, tt.n); got != tt.want {
+			t.Errorf("ordinal(en, %d) = %s, want %s", tt.n, got, tt.want)
+		}
+		if got := ordinal(LocaleJa, tt.n); got != "other" {
+			t.Errorf("ordinal(ja, %d) = %s, want other", tt.n, got)
+		}
+	}
+}
+
+func TestCardinal(t *testing.T) {
+	tests := []struct {
+		n    float64
+		want string
+	}{
+		{0, "other"}, {1, "one"}, {2, "other"}, {1.5, "other"}, {100, "other"},
+	}
+	for _, tt := range tests {
+		if got := cardinal(LocaleEn, tt.n); got != tt.want {
+			t.Errorf("cardinal(en, %g) = %s, want %s", tt.n, got, tt.want)
+		}
+		if got := cardinal(LocaleJa, tt.n); got != "other" {
+			t.Errorf("cardinal(ja, %g) = %s, want other", tt.n, got)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		locale Locale
+		got    func() string
+		want   string
+	}{
+		{LocaleEn, func() string { return Rank(1) }, "1st"},
+		{LocaleEn, func() string { return Rank(2) }, "2nd"},
+		{LocaleEn, func() string { return Rank(3) }, "3rd"},
+		{LocaleEn, func() string { return Rank(11) }, "11th"},
+		{LocaleEn, func() string { return Rank(22) }, "22nd"},
+		{LocaleJa, func() string { return Rank(1) }, "1位"},
+		{LocaleEn, func() string { return Points(1) }, "1 pt"},
+		{LocaleEn, func() string { return Points(0) }, "0 pts"},
+		{LocaleEn, func() string { return Points(100) }, "100 pts"},
+		{LocaleJa, func() string { return Points(100) }, "100点"},
+		{LocaleEn, func() string { return Seconds(12.345) }, "12.35 seconds"},
+		{LocaleJa, func() string { return Seconds(12.345) }, "12.35秒"},
+		{LocaleEn, func() string { return ShortSeconds(3) }, "3.00 s"},
+	}
+	for _, tt := range tests {
+		use(t, tt.locale)
+		if got := tt.got(); got != tt.want {
+			t.Errorf("%s: %q, want %q", tt.locale, got, tt.want)
+		}
+	}
+}
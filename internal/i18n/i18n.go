@@ -0,0 +1,177 @@
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// localeFiles は組み込みのメッセージカタログ（言語ごとに1ファイル）
+//
+//go:embed locales/*.json
+var localeFiles embed.FS
+
+// Locale は表示言語
+type Locale string
+
+// 表示言語
+const (
+	LocaleJa Locale = "ja"
+	LocaleEn Locale = "en"
+)
+
+// Locales は切り替えられる表示言語の一覧（設定画面での並び順）
+var Locales = []Locale{LocaleJa, LocaleEn}
+
+// baseLocale はメッセージが見つからない場合に使う言語（すべてのメッセージを持つ）
+const baseLocale = LocaleEn
+
+// Message は1つのメッセージの、複数形の種類ごとの文字列
+// カタログでは文字列をそのまま書くか、{"one": ..., "other": ...} のように種類ごとに書く
+type Message map[string]string
+
+// UnmarshalJSON は文字列だけのメッセージを "other" の文字列として読み込む
+func (m *Message) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*m = Message{"other": s}
+		return nil
+	}
+	var forms map[string]string
+	if err := json.Unmarshal(data, &forms); err != nil {
+		return err
+	}
+	*m = forms
+	return nil
+}
+
+// Catalog はメッセージのキーごとのメッセージ
+type Catalog map[string]Message
+
+// catalogs は言語ごとのメッセージカタログ
+var catalogs = map[Locale]Catalog{}
+
+// current は現在の表示言語
+var current = baseLocale
+
+func init() {
+	for _, l := range Locales {
+		data, err := localeFiles.ReadFile("locales/" + string(l) + ".json")
+		if err != nil {
+			panic(fmt.Sprintf("組み込みのメッセージカタログ %s がありません: %v", l, err))
+		}
+		var c Catalog
+		if err := json.Unmarshal(data, &c); err != nil {
+			panic(fmt.Sprintf("組み込みのメッセージカタログ %s を解析できません: %v", l, err))
+		}
+		catalogs[l] = c
+	}
+	if err := validate(); err != nil {
+		panic(fmt.Sprintf("組み込みのメッセージカタログが不正です: %v", err))
+	}
+}
+
+// validate はすべての言語が同じキーを持ち、すべてのメッセージに "other" があるかどうかを検証する
+func validate() error {
+	var problems []string
+	for _, l := range Locales {
+		for key, msg := range catalogs[l] {
+			if _, ok := msg["other"]; !ok {
+				problems = append(problems, fmt.Sprintf("%s: %s に other がありません", l, key))
+			}
+			if _, ok := catalogs[baseLocale][key]; !ok {
+				problems = append(problems, fmt.Sprintf("%s: %s は %s にありません", l, key, baseLocale))
+			}
+		}
+		for key := range catalogs[baseLocale] {
+			if _, ok := catalogs[l][key]; !ok {
+				problems = append(problems, fmt.Sprintf("%s: %s がありません", l, key))
+			}
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("\n  %s", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+// Current は現在の表示言語を返す
+func Current() Locale {
+	return current
+}
+
+// Set は表示言語を切り替える
+func Set(l Locale) error {
+	if _, ok := catalogs[l]; !ok {
+		return fmt.Errorf("未対応の言語です: %q", l)
+	}
+	current = l
+	return nil
+}
+
+// Next は一覧で次の表示言語を返す（delta が負なら前の言語）
+func Next(l Locale, delta int) Locale {
+	for i, candidate := range Locales {
+		if candidate == l {
+			n := len(Locales)
+			return Locales[((i+delta)%n+n)%n]
+		}
+	}
+	return Locales[0]
+}
+
+// Detect は環境変数から表示言語を推測する（日本語の環境でなければ英語）
+func Detect() Locale {
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if v := os.Getenv(name); v != "" {
+			if strings.HasPrefix(v, "ja") {
+				return LocaleJa
+			}
+			return LocaleEn
+		}
+	}
+	return LocaleEn
+}
+
+// T は現在の言語のメッセージを、args で書式化して返す
+// メッセージがなければ英語のメッセージを、それもなければキーをそのまま返す
+func T(key string, args ...any) string {
+	return format(lookup(key, "other"), args)
+}
+
+// Plural は n に応じた複数形のメッセージを、args で書式化して返す
+func Plural(key string, n float64, args ...any) string {
+	return format(lookup(key, cardinal(current, n)), args)
+}
+
+// Ordinal は n に応じた序数のメッセージ（1st, 2nd など）を、args で書式化して返す
+func Ordinal(key string, n int, args ...any) string {
+	return format(lookup(key, ordinal(current, n)), args)
+}
+
+// lookup は現在の言語で、キーと複数形の種類に対応する文字列を探す
+func lookup(key, form string) string {
+	for _, l := range []Locale{current, baseLocale} {
+		msg, ok := catalogs[l][key]
+		if !ok {
+			continue
+		}
+		if s, ok := msg[form]; ok {
+			return s
+		}
+		return msg["other"]
+	}
+	return key
+}
+
+// format は引数があればメッセージを書式化する
+func format(msg string, args []any) string {
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
@@ -0,0 +1,75 @@
+package i18n
+
+import "testing"
+
+func TestCatalogs(t *testing.T) {
+	if err := validate(); err != nil {
+		t.Fatalf("組み込みのメッセージカタログが不正です: %v", err)
+	}
+}
+
+func TestSet(t *testing.T) {
+	use(t, LocaleJa)
+	if err := Set("fr"); err == nil {
+		t.Error("未対応の言語がエラーになりませんでした")
+	}
+	if Current() != LocaleJa {
+		t.Errorf("未対応の言語で表示言語が変わりました: %s", Current())
+	}
+}
+
+func TestNext(t *testing.T) {
+	tests := []struct {
+		l     Locale
+		delta int
+		want  Locale
+	}{
+		{LocaleJa, 1, LocaleEn},
+		{LocaleEn, 1, LocaleJa},
+		{LocaleJa, -1, LocaleEn},
+		{LocaleEn, -3, LocaleJa},
+		{"fr", 1, LocaleJa},
+	}
+	for _, tt := range tests {
+		if got := Next(tt.l, tt.delta); got != tt.want {
+			t.Errorf("Next(%s, %d) = %s, want %s", tt.l, tt.delta, got, tt.want)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	use(t, LocaleJa)
+	catalogs[LocaleEn]["test.only_en"] = Message{"other": "only %d"}
+	t.Cleanup(func() { delete(catalogs[LocaleEn], "test.only_en") })
+
+	if got := T("test.only_en", 3); got != "only 3" {
+		t.Errorf("英語のメッセージに戻りませんでした: %q", got)
+	}
+	if got := T("test.missing"); got != "test.missing" {
+		t.Errorf("メッセージがない場合はキーを返すはずです: %q", got)
+	}
+	// 日本語は複数形を区別しないので "one" でも "other" の文字列を使う
+	if got := Plural("format.points", 1, 1); got != "1点" {
+		t.Errorf("Plural = %q, want 1点", got)
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		lcAll, lang string
+		want        Locale
+	}{
+		{"", "ja_JP.UTF-8", LocaleJa},
+		{"", "en_US.UTF-8", LocaleEn},
+		{"C", "ja_JP.UTF-8", LocaleEn}, // LC_ALL が優先される
+		{"", "", LocaleEn},
+	}
+	for _, tt := range tests {
+		t.Setenv("LC_ALL", tt.lcAll)
+		t.Setenv("LC_MESSAGES", "")
+		t.Setenv("LANG", tt.lang)
+		if got := Detect(); got != tt.want {
+			t.Errorf("LC_ALL=%q LANG=%q: Detect() = %s, want %s", tt.lcAll, tt.lang, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,8 @@
+//go:build !release
+
+package logging
+
+import "log/slog"
+
+// defaultLevel は開発用ビルドでのログの重要度の既定値
+const defaultLevel = slog.LevelInfo
@@ -0,0 +1,8 @@
+//go:build release
+
+package logging
+
+import "log/slog"
+
+// defaultLevel はリリースビルドでのログの重要度の既定値（警告以上だけを出力する）
+const defaultLevel = slog.LevelWarn
@@ -0,0 +1,65 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// Options はログの出力方法
+type Options struct {
+	Level  slog.Level // これより低い重要度のログは出力しない
+	Format string     // "text" か "json"
+	File   string     // 出力先のファイル（空なら標準エラー出力）
+}
+
+// DefaultOptions は既定の出力方法を返す
+// 重要度の既定値はビルドの種類で変わる（リリースビルドでは警告以上だけを出力する）
+func DefaultOptions() Options {
+	return Options{Level: defaultLevel, Format: "text"}
+}
+
+// ParseLevel はログの重要度の名前（debug / info / warn / error）を読み取る
+func ParseLevel(name string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(name)); err != nil {
+		return 0, fmt.Errorf("ログの重要度 %q は不明です（debug / info / warn / error）", name)
+	}
+	return level, nil
+}
+
+// Setup は出力方法に従ったロガーを既定のロガーにする
+// 標準の log パッケージの出力も同じロガーを通る。戻り値の Closer は出力先のファイルを閉じる
+func Setup(opts Options) (io.Closer, error) {
+	var out io.Writer = os.Stderr
+	var closer io.Closer = nopCloser{}
+	if opts.File != "" {
+		f, err := os.OpenFile(opts.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("ログファイルを開けません: %w", err)
+		}
+		out, closer = f, f
+	}
+
+	handlerOpts := &slog.HandlerOptions{Level: opts.Level}
+	var handler slog.Handler
+	switch strings.ToLower(opts.Format) {
+	case "", "text":
+		handler = slog.NewTextHandler(out, handlerOpts)
+	case "json":
+		handler = slog.NewJSONHandler(out, handlerOpts)
+	default:
+		closer.Close()
+		return nil, fmt.Errorf("ログの形式 %q は不明です（text / json）", opts.Format)
+	}
+
+	slog.SetDefault(slog.New(handler))
+	return closer, nil
+}
+
+// nopCloser は閉じる必要のない出力先（標準エラー出力）に使う Closer
+type nopCloser struct{}
+
+func (nopCloser) Close() error { return nil }
@@ -0,0 +1,183 @@
+package particle
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+)
+
+// Particle は1つの粒子
+type Particle struct {
+	X, Y      float64
+	VX, VY    float64
+	Gravity   float64 // 下向きの加速度（1秒あたりの速度の変化）
+	Drag      float64 // 1秒あたりの速度の減衰率（0なら減衰なし）
+	Life      float64 // 残りの寿命（秒）
+	MaxLife   float64
+	SizeStart float64
+	SizeEnd   float64
+	ColorStart color.RGBA
+	ColorEnd   color.RGBA
+	Additive  bool // 加算合成で描くかどうか
+}
+
+// Progress は寿命のうち経過した割合（0〜1）を返す
+func (p *Particle) Progress() float64 {
+	return 1 - p.Life/p.MaxLife
+}
+
+// Size は寿命に応じた現在の大きさを返す
+func (p *Particle) Size() float64 {
+	return lerp(p.SizeStart, p.SizeEnd, p.Progress())
+}
+
+// Color は寿命に応じた現在の色（透明度を含む）を返す
+func (p *Particle) Color() color.RGBA {
+	t := p.Progress()
+	return color.RGBA{
+		R: uint8(lerp(float64(p.ColorStart.R), float64(p.ColorEnd.R), t)),
+		G: uint8(lerp(float64(p.ColorStart.G), float64(p.ColorEnd.G), t)),
+		B: uint8(lerp(float64(p.ColorStart.B), float64(p.ColorEnd.B), t)),
+		A: uint8(lerp(float64(p.ColorStart.A), float64(p.ColorEnd.A), t)),
+	}
+}
+
+// Emitter は粒子の放出のしかた
+type Emitter struct {
+	Rate           float64 // 継続して放出する場合の1秒あたりの放出数
+	Lifetime       float64 // 粒子の寿命（秒）
+	LifetimeJitter float64 // 寿命のランダムな揺らぎ（秒）
+	Speed          float64 // 放出時の速さ（1秒あたりのピクセル）
+	SpeedJitter    float64 // 速さのランダムな揺らぎ
+	Angle          float64 // 放出する方向（ラジアン）
+	Spread         float64 // 方向の広がり（ラジアン、2πなら全方向）
+	Gravity        float64
+	Drag           float64
+	SizeStart      float64
+	SizeEnd        float64
+	ColorStart     color.RGBA
+	ColorEnd       color.RGBA
+	Additive       bool
+}
+
+// WithColor は放出する色だけを差し替えた放出のしかたを返す（終わりの色は透明にする）
+func (e Emitter) WithColor(clr color.RGBA) Emitter {
+	e.ColorStart = clr
+	e.ColorEnd = color.RGBA{clr.R, clr.G, clr.B, 0}
+	return e
+}
+
+// stream は一定時間放出し続ける放出源
+type stream struct {
+	emitter   Emitter
+	x, y      float64
+	remaining float64 // 残りの放出時間（秒）
+	pending   float64 // 放出しきれていない粒子の端数
+}
+
+// System は粒子をまとめて管理する
+// 粒子は作成時に確保した配列を使い回し、生きている粒子は配列の先頭に詰めて並べる
+type System struct {
+	particles []Particle
+	alive     int
+	streams   []stream
+}
+
+// NewSystem は最大 capacity 個の粒子を持てる粒子システムを作成する
+func NewSystem(capacity int) *System {
+	return &System{
+		particles: make([]Particle, capacity),
+		streams:   make([]stream, 0, 8),
+	}
+}
+
+// Particles は生きている粒子を返す（次の Update までの間だけ有効）
+func (s *System) Particles() []Particle {
+	return s.particles[:s.alive]
+}
+
+// Burst は (x, y) から n 個の粒子を一度に放出する
+// 配列が埋まっている場合、あふれた粒子は捨てられる
+func (s *System) Burst(e Emitter, x, y float64, n int) {
+	for i := 0; i < n && s.alive < len(s.particles); i++ {
+		s.particles[s.alive] = e.spawn(x, y)
+		s.alive++
+	}
+}
+
+// Stream は (x, y) から duration 秒の間、放出のしかたの Rate に従って粒子を放出し続ける
+func (s *System) Stream(e Emitter, x, y, duration float64) {
+	s.streams = append(s.streams, stream{emitter: e, x: x, y: y, remaining: duration})
+}
+
+// Update は放出と粒子の移動・寿命を dt 秒分進める
+func (s *System) Update(dt float64) {
+	// 継続して放出する放出源
+	streams := s.streams[:0]
+	for _, st := range s.streams {
+		st.pending += st.emitter.Rate * dt
+		n := int(st.pending)
+		st.pending -= float64(n)
+		s.Burst(st.emitter, st.x, st.y, n)
+
+		st.remaining -= dt
+		if st.remaining > 0 {
+			streams = append(streams, st)
+		}
+	}
+	s.streams = streams
+
+	// 寿命が尽きた粒子は末尾の粒子と入れ替えて詰める
+	for i := 0; i < s.alive; {
+		p := &s.particles[i]
+		p.Life -= dt
+		if p.Life <= 0 {
+			s.alive--
+			s.particles[i] = s.particles[s.alive]
+			continue
+		}
+
+		p.VY += p.Gravity * dt
+		if p.Drag > 0 {
+			damping := math.Max(0, 1-p.Drag*dt)
+			p.VX *= damping
+			p.VY *= damping
+		}
+		p.X += p.VX * dt
+		p.Y += p.VY * dt
+		i++
+	}
+}
+
+// Clear はすべての粒子と放出源を消す
+func (s *System) Clear() {
+	s.alive = 0
+	s.streams = s.streams[:0]
+}
+
+// spawn は放出のしかたに従って1つの粒子を作成する
+func (e Emitter) spawn(x, y float64) Particle {
+	angle := e.Angle + (rand.Float64()-0.5)*e.Spread
+	speed := math.Max(0, e.Speed+(rand.Float64()*2-1)*e.SpeedJitter)
+	life := math.Max(0.05, e.Lifetime+(rand.Float64()*2-1)*e.LifetimeJitter)
+	return Particle{
+		X:          x,
+		Y:          y,
+		VX:         math.Cos(angle) * speed,
+		VY:         math.Sin(angle) * speed,
+		Gravity:    e.Gravity,
+		Drag:       e.Drag,
+		Life:       life,
+		MaxLife:    life,
+		SizeStart:  e.SizeStart,
+		SizeEnd:    e.SizeEnd,
+		ColorStart: e.ColorStart,
+		ColorEnd:   e.ColorEnd,
+		Additive:   e.Additive,
+	}
+}
+
+// lerp は a から b への t の割合の値を返す
+func lerp(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
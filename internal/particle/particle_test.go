@@ -0,0 +1,112 @@
+package particle
+
+import (
+	"image/color"
+	"testing"
+)
+
+// fixed は揺らぎのない放出のしかた（右向きに毎秒100ピクセル、寿命1秒）
+var fixed = Emitter{
+	Lifetime: 1, Speed: 100,
+	SizeStart: 4, SizeEnd: 0,
+	ColorStart: color.RGBA{255, 0, 0, 255}, ColorEnd: color.RGBA{0, 0, 255, 0},
+}
+
+func TestBurstCapacity(t *testing.T) {
+	s := NewSystem(10)
+	s.Burst(fixed, 0, 0, 6)
+	s.Burst(fixed, 0, 0, 6) // あふれた分は捨てる
+	if n := len(s.Particles()); n != 10 {
+		t.Errorf("%d 個の粒子があります、want 10", n)
+	}
+	s.Clear()
+	if n := len(s.Particles()); n != 0 {
+		t.Errorf("Clear の後に %d 個の粒子が残っています", n)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	s := NewSystem(10)
+	s.Burst(fixed, 10, 20, 1)
+	s.Update(0.5)
+
+	ps := s.Particles()
+	if len(ps) != 1 {
+		t.Fatalf("%d 個の粒子があります、want 1", len(ps))
+	}
+	p := ps[0]
+	if !nearlyEqual(p.X, 60) || !nearlyEqual(p.Y, 20) {
+		t.Errorf("位置 = (%g, %g), want (60, 20)", p.X, p.Y)
+	}
+	if !nearlyEqual(p.Progress(), 0.5) || !nearlyEqual(p.Size(), 2) {
+		t.Errorf("Progress() = %g, Size() = %g", p.Progress(), p.Size())
+	}
+	if c := p.Color(); c.R != 127 || c.B != 127 || c.A != 127 {
+		t.Errorf("Color() = %v", c)
+	}
+
+	// 寿命が尽きた粒子は消える
+	s.Update(0.5)
+	if n := len(s.Particles()); n != 0 {
+		t.Errorf("寿命が尽きた粒子が %d 個残っています", n)
+	}
+}
+
+func TestUpdateCompactsAlive(t *testing.T) {
+	s := NewSystem(10)
+	short := fixed
+	short.Lifetime = 0.1
+	s.Burst(fixed, 0, 0, 2)
+	s.Burst(short, 0, 0, 2)
+	s.Burst(fixed, 0, 0, 1)
+	s.Update(0.2)
+
+	ps := s.Particles()
+	if len(ps) != 3 {
+		t.Fatalf("%d 個の粒子があります、want 3", len(ps))
+	}
+	for i, p := range ps {
+		if p.MaxLife != fixed.Lifetime {
+			t.Errorf("Particles()[%d] は寿命が尽きた粒子です: %+v", i, p)
+		}
+	}
+}
+
+func TestStream(t *testing.T) {
+	s := NewSystem(100)
+	e := fixed
+	e.Rate = 10
+	s.Stream(e, 0, 0, 1)
+
+	// 1フレームに1個未満でも端数を持ち越して放出する
+	for i := 0; i < 60; i++ {
+		s.Update(1.0 / 60)
+	}
+	if n := len(s.Particles()); n < 9 || n > 10 {
+		t.Errorf("1秒間に %d 個の粒子を放出しました、want 約10", n)
+	}
+
+	// 放出時間が過ぎたら放出をやめる
+	before := len(s.Particles())
+	s.Update(0.5)
+	if n := len(s.Particles()); n > before {
+		t.Errorf("放出時間の後も放出しています（%d → %d）", before, n)
+	}
+}
+
+func TestWithColor(t *testing.T) {
+	clr := color.RGBA{10, 20, 30, 255}
+	e := PickupBurst.WithColor(clr)
+	if e.ColorStart != clr || e.ColorEnd != (color.RGBA{10, 20, 30, 0}) {
+		t.Errorf("WithColor = %v → %v", e.ColorStart, e.ColorEnd)
+	}
+	if PickupBurst.ColorStart == clr {
+		t.Error("WithColor が元の放出のしかたを変えました")
+	}
+}
+
+// nearlyEqual は浮動小数点の誤差を許して比較する
+func nearlyEqual(a, b float64) bool {
+	const epsilon = 1e-9
+	return a-b < epsilon && b-a < epsilon
+}
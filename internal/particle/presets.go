@@ -0,0 +1,64 @@
+package particle
+
+import (
+	"image/color"
+	"math"
+)
+
+// ゲーム中の出来事ごとの放出のしかた
+var (
+	// CancelSpark はボムで消した弾から飛び散る火花（弾の色に差し替えて使う）
+	CancelSpark = Emitter{
+		Lifetime: 0.35, LifetimeJitter: 0.1,
+		Speed: 120, SpeedJitter: 60,
+		Spread: 2 * math.Pi,
+		Drag: 3,
+		SizeStart: 2.5, SizeEnd: 0.5,
+		ColorStart: color.RGBA{255, 255, 255, 255}, ColorEnd: color.RGBA{255, 255, 255, 0},
+		Additive: true,
+	}
+
+	// ShieldSpark はシールドが弾を防いだときの火花
+	ShieldSpark = Emitter{
+		Lifetime: 0.4, LifetimeJitter: 0.1,
+		Speed: 220, SpeedJitter: 80,
+		Spread: 2 * math.Pi,
+		Drag: 4,
+		SizeStart: 3, SizeEnd: 0.5,
+		ColorStart: color.RGBA{150, 240, 255, 255}, ColorEnd: color.RGBA{0, 120, 255, 0},
+		Additive: true,
+	}
+
+	// PickupBurst はアイテムを取ったときの光の輪（アイテムの色に差し替えて使う）
+	PickupBurst = Emitter{
+		Lifetime: 0.5, LifetimeJitter: 0.1,
+		Speed: 90, SpeedJitter: 20,
+		Spread: 2 * math.Pi,
+		Drag: 2,
+		SizeStart: 3, SizeEnd: 1,
+		ColorStart: color.RGBA{255, 240, 150, 255}, ColorEnd: color.RGBA{255, 240, 150, 0},
+		Additive: true,
+	}
+
+	// DeathBlast はプレイヤーがやられたときの爆発の閃光
+	DeathBlast = Emitter{
+		Lifetime: 0.8, LifetimeJitter: 0.3,
+		Speed: 260, SpeedJitter: 140,
+		Spread: 2 * math.Pi,
+		Drag: 2.5,
+		SizeStart: 5, SizeEnd: 1,
+		ColorStart: color.RGBA{255, 230, 120, 255}, ColorEnd: color.RGBA{255, 60, 0, 0},
+		Additive: true,
+	}
+
+	// DeathDebris は爆発の後に降り注ぐ破片
+	DeathDebris = Emitter{
+		Rate: 120,
+		Lifetime: 1.2, LifetimeJitter: 0.4,
+		Speed: 140, SpeedJitter: 60,
+		Angle: -math.Pi / 2, Spread: math.Pi * 1.2,
+		Gravity: 300,
+		SizeStart: 2.5, SizeEnd: 2,
+		ColorStart: color.RGBA{255, 180, 80, 255}, ColorEnd: color.RGBA{120, 40, 20, 0},
+	}
+)
@@ -0,0 +1,177 @@
+package pattern
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+
+	"game/internal/entity"
+)
+
+// Context は弾幕パターンが弾を生成するための情報
+type Context struct {
+	Width, Height    float64    // 画面サイズ
+	BulletSize       float64
+	MinSpeed         float64
+	MaxSpeed         float64
+	SpeedMultiplier  float64    // 難易度による弾速の倍率
+	TargetX, TargetY float64    // 狙う対象（プレイヤー）の位置
+	Count            int        // 1回の発射での弾の数の目安
+	Rand             *rand.Rand // 発射位置や向きを決める乱数（シードが同じなら同じ弾幕になる）
+}
+
+// Func は弾幕パターンの生成関数
+type Func func(ctx Context) []*entity.Bullet
+
+// registry は名前で引ける弾幕パターンの一覧
+var registry = map[string]Func{}
+
+// Register は弾幕パターンを登録する
+func Register(name string, f Func) {
+	registry[name] = f
+}
+
+// Get は名前から弾幕パターンを取得する
+func Get(name string) (Func, bool) {
+	f, ok := registry[name]
+	return f, ok
+}
+
+// Names は登録されている弾幕パターンの名前を昇順で返す
+func Names() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Spawn は指定したパターンで弾を生成する（未登録の名前ならnil）
+func Spawn(name string, ctx Context) []*entity.Bullet {
+	f, ok := registry[name]
+	if !ok {
+		return nil
+	}
+	return f(ctx)
+}
+
+func init() {
+	Register("random", Random)
+	Register("aimed", Aimed)
+	Register("ring", Ring)
+	Register("stream", Stream)
+	Register("homing", Homing)
+	Register("split", Split)
+}
+
+// Random は画面の四方からランダムな方向へ弾を発射する
+func Random(ctx Context) []*entity.Bullet {
+	bullets := make([]*entity.Bullet, 0, ctx.Count)
+	for i := 0; i < ctx.Count; i++ {
+		bullets = append(bullets, entity.NewRandomBullet(ctx.Rand, ctx.Width, ctx.Height, ctx.BulletSize, ctx.MinSpeed, ctx.MaxSpeed, ctx.SpeedMultiplier))
+	}
+	return bullets
+}
+
+// Aimed は画面の端からプレイヤーを狙って弾を発射する
+func Aimed(ctx Context) []*entity.Bullet {
+	bullets := make([]*entity.Bullet, 0, ctx.Count)
+	for i := 0; i < ctx.Count; i++ {
+		x, y := edgePoint(ctx)
+		speed := randomSpeed(ctx)
+		
+		// わずかに狙いをずらす
+		angle := math.Atan2(ctx.TargetY-y, ctx.TargetX-x) + (ctx.Rand.Float64()*2-1)*0.15
+		bullets = append(bullets, entity.NewKindBullet(entity.BulletAimed, x, y, math.Cos(angle)*speed, math.Sin(angle)*speed, ctx.BulletSize))
+	}
+	return bullets
+}
+
+// Ring は画面の端の1点から全方位に弾を放つ
+func Ring(ctx Context) []*entity.Bullet {
+	n := ctx.Count
+	if n < 8 {
+		n = 8
+	}
+	
+	x, y := edgePoint(ctx)
+	speed := (ctx.MinSpeed + ctx.MaxSpeed) / 2 * ctx.SpeedMultiplier
+	offset := ctx.Rand.Float64() * 2 * math.Pi
+	
+	bullets := make([]*entity.Bullet, 0, n)
+	for i := 0; i < n; i++ {
+		angle := offset + float64(i)*2*math.Pi/float64(n)
+		bullets = append(bullets, entity.NewBullet(x, y, math.Cos(angle)*speed, math.Sin(angle)*speed, ctx.BulletSize))
+	}
+	return bullets
+}
+
+// Stream はプレイヤーを狙った弾を速度違いで一列に発射する
+func Stream(ctx Context) []*entity.Bullet {
+	n := ctx.Count + 2
+	
+	x, y := edgePoint(ctx)
+	angle := math.Atan2(ctx.TargetY-y, ctx.TargetX-x)
+	
+	// 速い弾から遅い弾まで段階的に速度を変え、進むにつれて列が伸びるようにする
+	bullets := make([]*entity.Bullet, 0, n)
+	for i := 0; i < n; i++ {
+		t := float64(i) / float64(n-1)
+		speed := (ctx.MaxSpeed - (ctx.MaxSpeed-ctx.MinSpeed)*t) * ctx.SpeedMultiplier
+		bullets = append(bullets, entity.NewKindBullet(entity.BulletAimed, x, y, math.Cos(angle)*speed, math.Sin(angle)*speed, ctx.BulletSize))
+	}
+	return bullets
+}
+
+// Homing は画面の端からプレイヤーを追いかける誘導弾を少数発射する
+// 曲がりながら迫るため、数は他のパターンより少なく、速さは遅めにする
+func Homing(ctx Context) []*entity.Bullet {
+	n := max(1, ctx.Count/4)
+	
+	bullets := make([]*entity.Bullet, 0, n)
+	for i := 0; i < n; i++ {
+		x, y := edgePoint(ctx)
+		speed := ctx.MinSpeed * ctx.SpeedMultiplier
+		angle := math.Atan2(ctx.TargetY-y, ctx.TargetX-x)
+		bullets = append(bullets, entity.NewKindBullet(entity.BulletHoming, x, y, math.Cos(angle)*speed, math.Sin(angle)*speed, ctx.BulletSize))
+	}
+	return bullets
+}
+
+// Split は画面の内側へ向かい、途中で全方位に分裂する弾を発射する
+func Split(ctx Context) []*entity.Bullet {
+	n := max(1, ctx.Count/3)
+	
+	bullets := make([]*entity.Bullet, 0, n)
+	for i := 0; i < n; i++ {
+		x, y := edgePoint(ctx)
+		speed := randomSpeed(ctx)
+		
+		// 画面の中央付近を狙い、分裂したときに弾が画面内に広がるようにする
+		cx := ctx.Width * (0.25 + ctx.Rand.Float64()*0.5)
+		cy := ctx.Height * (0.25 + ctx.Rand.Float64()*0.5)
+		angle := math.Atan2(cy-y, cx-x)
+		bullets = append(bullets, entity.NewKindBullet(entity.BulletSplitting, x, y, math.Cos(angle)*speed, math.Sin(angle)*speed, ctx.BulletSize))
+	}
+	return bullets
+}
+
+// edgePoint は画面の四辺のすぐ外側のランダムな点を返す
+func edgePoint(ctx Context) (float64, float64) {
+	switch ctx.Rand.Intn(4) {
+	case 0: // 上
+		return ctx.Rand.Float64() * ctx.Width, -ctx.BulletSize
+	case 1: // 右
+		return ctx.Width + ctx.BulletSize, ctx.Rand.Float64() * ctx.Height
+	case 2: // 下
+		return ctx.Rand.Float64() * ctx.Width, ctx.Height + ctx.BulletSize
+	default: // 左
+		return -ctx.BulletSize, ctx.Rand.Float64() * ctx.Height
+	}
+}
+
+// randomSpeed は難易度を考慮したランダムな弾速を返す
+func randomSpeed(ctx Context) float64 {
+	return (ctx.Rand.Float64()*(ctx.MaxSpeed-ctx.MinSpeed) + ctx.MinSpeed) * ctx.SpeedMultiplier
+}
@@ -0,0 +1,92 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// maxQuadsPerCall は1回の DrawTriangles で描ける四角形の数
+// インデックスが uint16 なので、頂点数は 65536 と ebiten.MaxVertexCount の小さい方に収める
+var maxQuadsPerCall = min(ebiten.MaxVertexCount, 1<<16) / 4
+
+// quadBatch は同じテクスチャを使う四角形を頂点バッファにまとめ、少ない DrawTriangles の呼び出しで描く
+// バッファは描画のたびに使い回す
+type quadBatch struct {
+	vertices []ebiten.Vertex
+	indices  []uint16
+}
+
+// reset はバッファを空にする（確保した領域は残す）
+func (q *quadBatch) reset() {
+	q.vertices = q.vertices[:0]
+	q.indices = q.indices[:0]
+}
+
+// len はバッファにある四角形の数を返す
+func (q *quadBatch) len() int {
+	return len(q.vertices) / 4
+}
+
+// add は (x, y) を中心に幅 w・高さ h で angle だけ回転させた四角形に、テクスチャの src の範囲を色 clr を掛けて貼るよう追加する
+func (q *quadBatch) add(x, y, w, h, angle float64, src image.Rectangle, clr color.RGBA) {
+	// インデックスは DrawTriangles の1回分ごとに0から数え直す
+	base := uint16(q.len() % maxQuadsPerCall * 4)
+
+	sin, cos := math.Sincos(angle)
+	hw, hh := w/2, h/2
+	r := float32(clr.R) / 255
+	g := float32(clr.G) / 255
+	b := float32(clr.B) / 255
+	a := float32(clr.A) / 255
+
+	corners := [4][2]float64{{-hw, -hh}, {hw, -hh}, {-hw, hh}, {hw, hh}}
+	sources := [4]image.Point{
+		src.Min,
+		{src.Max.X, src.Min.Y},
+		{src.Min.X, src.Max.Y},
+		src.Max,
+	}
+	for i, c := range corners {
+		q.vertices = append(q.vertices, ebiten.Vertex{
+			DstX:   float32(x + c[0]*cos - c[1]*sin),
+			DstY:   float32(y + c[0]*sin + c[1]*cos),
+			SrcX:   float32(sources[i].X),
+			SrcY:   float32(sources[i].Y),
+			ColorR: r, ColorG: g, ColorB: b, ColorA: a,
+		})
+	}
+	q.indices = append(q.indices, base, base+1, base+2, base+1, base+3, base+2)
+}
+
+// flush はバッファの四角形をテクスチャで描画する
+// 頂点数の上限を超えない限り DrawTriangles の呼び出しは1回になる
+func (q *quadBatch) flush(dst, texture *ebiten.Image, blend ebiten.Blend) {
+	op := &ebiten.DrawTrianglesOptions{Blend: blend}
+	for start := 0; start < q.len(); start += maxQuadsPerCall {
+		end := min(start+maxQuadsPerCall, q.len())
+		dst.DrawTriangles(q.vertices[start*4:end*4], q.indices[start*6:end*6], texture, op)
+	}
+}
+
+// newDiscTexture は縁を滑らかにした白い円のテクスチャを作成する
+// softness が大きいほど外側へなだらかに透明になる
+func newDiscTexture(size int, softness float64) *ebiten.Image {
+	pixels := make([]byte, size*size*4)
+	center := float64(size) / 2
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			d := math.Hypot(float64(x)+0.5-center, float64(y)+0.5-center) / center
+			alpha := math.Max(0, math.Min(1, (1-d)/softness))
+			i := (y*size + x) * 4
+			// 乗算済みアルファで書き込む
+			v := byte(alpha * 255)
+			pixels[i], pixels[i+1], pixels[i+2], pixels[i+3] = v, v, v, v
+		}
+	}
+	img := ebiten.NewImage(size, size)
+	img.WritePixels(pixels)
+	return img
+}
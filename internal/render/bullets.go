@@ -0,0 +1,63 @@
+package render
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"game/internal/entity"
+	"game/internal/theme"
+)
+
+// bulletDiscTexture はスプライトのない弾を描く白い円のテクスチャ
+var bulletDiscTexture *ebiten.Image
+
+// dangerGlowTexture は危険度の高い弾の周りの光を描く、外側ほど透明になる白い円のテクスチャ
+var dangerGlowTexture *ebiten.Image
+
+// dangerGlowScale は弾の大きさに対する周りの光の直径の倍率
+const dangerGlowScale = 2.2
+
+// 弾の頂点バッファ（毎フレーム使い回す）
+var (
+	dangerGlowBatch   quadBatch // 危険度の高い弾の周りの光
+	spriteBulletBatch quadBatch // アトラスの絵で描く弾
+	discBulletBatch   quadBatch // 絵がなく円で描く弾
+)
+
+// DrawBullets はすべての弾を描画する
+// 弾の色は振る舞いの種類と速さの段階で、周りの光は危険度で決まる（現在の配色に従う）
+// 弾ごとに図形を描くのではなく、弾の四角形を1つの頂点バッファに書き込み、テクスチャごとに1回の DrawTriangles で描く
+func DrawBullets(screen *ebiten.Image, bullets []*entity.Bullet) {
+	if bulletDiscTexture == nil {
+		bulletDiscTexture = newDiscTexture(32, 0.1)
+		dangerGlowTexture = newDiscTexture(32, 1)
+	}
+	
+	dangerGlowBatch.reset()
+	spriteBulletBatch.reset()
+	discBulletBatch.reset()
+	atlas := sprites()
+	palette := theme.Current()
+	for _, b := range bullets {
+		if glow := palette.DangerColor(b.Danger()); glow.A > 0 {
+			d := b.Size * 2 * dangerGlowScale
+			dangerGlowBatch.add(b.X, b.Y, d, d, 0, dangerGlowTexture.Bounds(), glow)
+		}
+		
+		clr := palette.BulletColor(b.Kind, b.SpeedTier())
+		name, w, h := bulletSprite(b)
+		if img, ok := atlas.Frame(name); ok {
+			// 進行方向に回転させる
+			spriteBulletBatch.add(b.X, b.Y, w, h, bulletAngle(b), img.Bounds(), clr)
+			continue
+		}
+		
+		// 絵がなければ円で描く
+		discBulletBatch.add(b.X, b.Y, b.Size*2, b.Size*2, 0, bulletDiscTexture.Bounds(), clr)
+	}
+	
+	dangerGlowBatch.flush(screen, dangerGlowTexture, ebiten.BlendSourceOver)
+	if spriteBulletBatch.len() > 0 {
+		spriteBulletBatch.flush(screen, atlas.Texture(), ebiten.BlendSourceOver)
+	}
+	discBulletBatch.flush(screen, bulletDiscTexture, ebiten.BlendSourceOver)
+}
@@ -0,0 +1,68 @@
+package render
+
+import (
+	"math"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"game/internal/config"
+	"game/internal/game"
+	"game/internal/i18n"
+	"game/internal/text"
+)
+
+// dailyLabel はタイトル画面に表示する今日のデイリーチャレンジの案内を返す
+func dailyLabel(g *game.Game) string {
+	c := g.DailyChallenge()
+	mods := make([]string, len(c.Modifiers))
+	for i, m := range c.Modifiers {
+		mods[i] = i18n.T("daily." + string(m))
+	}
+	label := i18n.T("title.daily", strings.Join(mods, " / "))
+	if g.Daily.Attempted(c.Date) {
+		label += i18n.T("title.daily_done")
+	}
+	if streak := g.Daily.CurrentStreak(c.Date); streak > 0 {
+		label += i18n.T("title.daily_streak", streak)
+	}
+	if g.Daily.Err != nil {
+		label += i18n.T("title.daily_unsaved")
+	}
+	return label
+}
+
+// drawDailyRanking はゲームオーバー画面にデイリーチャレンジの記録と連続日数を表示する（徐々に表示されるアニメーション）
+func drawDailyRanking(screen *ebiten.Image, g *game.Game) {
+	if g.RankingAppear <= 0 {
+		return
+	}
+	centerX := float64(config.ScreenWidth) / 2
+	titleY := float64(config.ScreenHeight)/2 + 30
+	text.Draw(screen, i18n.T("gameover.daily_ranking", g.Run.Daily), centerX, titleY, menuStyle)
+
+	records := g.Daily.Top(config.DailyRankingScores)
+	shown := int(float64(len(records)) * g.RankingAppear)
+	for i := 0; i < shown && i < len(records); i++ {
+		// アニメーション効果（少しずつ右から現れる）
+		offset := math.Max(0, (1.0-g.RankingAppear)*100)
+		y := titleY + 30 + float64(i)*24
+
+		// 順位・日付・時間・得点を列に揃えて表示（今日の記録は強調する）
+		style := rankStyle
+		if records[i].Date == g.Run.Daily {
+			style.Color = scoreStyle.Color
+		}
+		text.Draw(screen, i18n.Rank(i+1), centerX-130+offset, y, style)
+		text.Draw(screen, records[i].Date, centerX-10+offset, y, style)
+		text.Draw(screen, i18n.ShortSeconds(records[i].Time), centerX+70+offset, y, style)
+		text.Draw(screen, i18n.Points(records[i].Score), centerX+150+offset, y, style)
+	}
+
+	// 連続日数
+	streakY := titleY + 30 + float64(config.DailyRankingScores)*24 + 6
+	text.Draw(screen, i18n.T("gameover.daily_streak", g.Daily.CurrentStreak(g.Run.Daily), g.Daily.BestStreak()), centerX, streakY, noteStyle)
+	if g.Daily.Err != nil {
+		text.Draw(screen, i18n.T("gameover.daily_unsaved"), centerX, streakY+22, noteStyle)
+	}
+}
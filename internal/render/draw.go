@@ -4,44 +4,135 @@ import (
 	"fmt"
 	"image/color"
 	"math"
+	"strings"
 
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/colorm"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
 
 	"game/internal/config"
 	"game/internal/entity"
 	"game/internal/game"
+	"game/internal/i18n"
+	"game/internal/text"
+	"game/internal/theme"
 )
 
+// 文字の描画方法
+var (
+	headingStyle  = text.Style{Font: text.FontDisplay, Size: 32, Align: text.AlignCenter, Outline: 2, Shadow: 4}
+	menuStyle     = text.Style{Size: 18, Align: text.AlignCenter, Shadow: 2}
+	menuItemStyle = text.Style{Size: 18, Shadow: 2}
+	noteStyle     = text.Style{Size: 14, Align: text.AlignCenter, Color: color.RGBA{180, 180, 200, 255}, Shadow: 1}
+	rankStyle     = text.Style{Size: 16, Align: text.AlignRight, Shadow: 1}
+	scoreStyle    = text.Style{Size: 20, Align: text.AlignCenter, Color: color.RGBA{255, 240, 120, 255}, Outline: 1}
+	labelStyle    = text.Style{Size: 11, Align: text.AlignCenter, Outline: 1}
+	hudStyle      = text.Style{Size: 14, Shadow: 1}
+)
+
+// worldLayer はスローモーション中に彩度を落として合成するための描画先
+var worldLayer *ebiten.Image
+
+// playfieldLayer はプレイフィールドを論理サイズで描くための描画先
+var playfieldLayer *ebiten.Image
+
+// canvasLayer はプレイフィールドとサイドパネルを並べるための描画先（縦横比ごとにサイズが変わる）
+var canvasLayer *ebiten.Image
+
 // Draw はゲームの状態を描画する
+// プレイフィールドを論理サイズで描いてからキャンバスに並べ、ウィンドウに合わせて拡大縮小する
 func Draw(screen *ebiten.Image, g *game.Game) {
-	// 背景を黒で塗りつぶす
-	screen.Fill(color.RGBA{20, 20, 40, 255})
-
-	// シールドアイテムを描画
-	drawShieldItem(screen, g.ShieldItem)
+	if playfieldLayer == nil {
+		playfieldLayer = ebiten.NewImage(config.ScreenWidth, config.ScreenHeight)
+	}
+	drawPlayfield(playfieldLayer, g)
+	
+	// キャンバスにプレイフィールドとサイドパネルを並べる
+	canvasWidth, canvasHeight := g.Viewport.CanvasSize()
+	if canvasLayer == nil || canvasLayer.Bounds().Dx() != canvasWidth || canvasLayer.Bounds().Dy() != canvasHeight {
+		if canvasLayer != nil {
+			canvasLayer.Deallocate()
+		}
+		canvasLayer = ebiten.NewImage(canvasWidth, canvasHeight)
+	}
+	canvasLayer.Fill(theme.Current().Panel)
+	
+	// 画面の揺れの分だけプレイフィールドをずらして並べる
+	playfield := g.Viewport.PlayfieldRect()
+	shakeX, shakeY := g.Feedback.Offset()
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(float64(playfield.Min.X)+shakeX, float64(playfield.Min.Y)+shakeY)
+	canvasLayer.DrawImage(playfieldLayer, op)
+	
+	// サイドパネルがある場合はプレイフィールドとの境界線を描く
+	if left, right := g.Viewport.SidePanels(); !left.Empty() || !right.Empty() {
+		borderColor := theme.Current().Border
+		ebitenutil.DrawRect(canvasLayer, float64(playfield.Min.X-2), 0, 2, float64(canvasHeight), borderColor)
+		ebitenutil.DrawRect(canvasLayer, float64(playfield.Max.X), 0, 2, float64(canvasHeight), borderColor)
+	}
+	
+	// サイドパネルのHUDを表示（タイトル画面と設定画面では表示しない）
+	if g.Scene != game.SceneTitle && g.Scene != game.SceneSettings && g.Scene != game.ScenePractice {
+		drawHUD(canvasLayer, g, false)
+	}
+	
+	// 被弾やボムのフラッシュをキャンバス全体に重ねる
+	if flash, ok := g.Feedback.FlashOverlay(); ok {
+		ebitenutil.DrawRect(canvasLayer, 0, 0, float64(canvasWidth), float64(canvasHeight), flash)
+	}
+	
+	// キャンバスをウィンドウに収まるよう拡大縮小し、余白は黒帯にする
+	scale, offsetX, offsetY := g.Viewport.Transform()
+	screen.Fill(color.Black)
+	op = &ebiten.DrawImageOptions{}
+	op.GeoM.Scale(scale, scale)
+	op.GeoM.Translate(offsetX, offsetY)
+	if g.Viewport.IntegerScale {
+		op.Filter = ebiten.FilterNearest
+	} else {
+		op.Filter = ebiten.FilterLinear
+	}
+	screen.DrawImage(canvasLayer, op)
+}
 
-	// 弾を描画
-	for _, b := range g.Bullets {
-		drawBullet(screen, b)
+// drawPlayfield はプレイフィールド（論理サイズ）にゲームの状態を描画する
+func drawPlayfield(screen *ebiten.Image, g *game.Game) {
+	// スローモーション中は弾やアイテムを別レイヤーに描いて彩度を落とす
+	if g.SlowMotionTime > 0 {
+		if worldLayer == nil {
+			worldLayer = ebiten.NewImage(config.ScreenWidth, config.ScreenHeight)
+		}
+		drawWorld(worldLayer, g)
+		drawDesaturated(screen, worldLayer, g)
+	} else {
+		drawWorld(screen, g)
 	}
 
-	// 爆発エフェクトを描画
-	if g.Explosion != nil && g.Explosion.Active {
-		drawExplosion(screen, g.Explosion)
+	// タイトル画面では弾幕を背景にして難易度選択を表示
+	if g.Scene == game.SceneTitle {
+		drawTitle(screen, g)
+		return
+	}
+	
+	// 設定画面も弾幕を背景にして表示
+	if g.Scene == game.SceneSettings {
+		drawSettings(screen, g)
+		return
+	}
+	
+	// 練習モードの設定画面も弾幕を背景にして表示
+	if g.Scene == game.ScenePractice {
+		drawPractice(screen, g)
+		return
 	}
 
 	// プレイヤーを描画
-	if !g.GameOver {
+	if g.Scene != game.SceneGameOver {
 		drawPlayer(screen, g.Player, g.CurrentTime)
 	}
 
-	// 経過時間と難易度を表示
-	timeText := fmt.Sprintf("Time: %.2f  Difficulty: %d", g.CurrentTime, g.Difficulty)
-	ebitenutil.DebugPrintAt(screen, timeText, 20, 20)
-
-	// 爆発スキルのクールダウン表示
-	drawBombCooldown(screen, g.Player)
+	// プレイフィールドに重ねるHUDを表示
+	drawHUD(screen, g, true)
 
 	// スコアアニメーションを描画
 	for _, anim := range g.ScoreAnimations {
@@ -49,15 +140,74 @@ func Draw(screen *ebiten.Image, g *game.Game) {
 	}
 
 	// ゲームオーバー時の表示
-	if g.GameOver {
+	if g.Scene == game.SceneGameOver {
 		drawGameOver(screen, g)
 	}
+	
+	// 一時停止中は弾の凡例を重ねる
+	if g.Scene == game.ScenePaused {
+		drawPause(screen)
+	}
+}
+
+// drawWorld は背景・アイテム・弾・爆発など、時間の流れの影響を受けるものを描画する
+func drawWorld(screen *ebiten.Image, g *game.Game) {
+	// 背景を配色の背景色で塗りつぶす
+	screen.Fill(theme.Current().Background)
+
+	// アイテムを描画
+	for _, item := range g.Items {
+		drawItem(screen, item)
+	}
+
+	// 弾をまとめて描画
+	DrawBullets(screen, g.Bullets)
+
+	// 爆発エフェクトを描画
+	if g.Explosion != nil && g.Explosion.Active {
+		drawExplosion(screen, g.Explosion)
+	}
+	
+	// 得点アイテムを描画
+	for _, item := range g.ScoreItems {
+		drawScoreItem(screen, item)
+	}
+	
+	// 火花や破片などの粒子を描画
+	drawParticles(screen, g.Particles)
+}
+
+// drawDesaturated はスローモーションの残り時間に応じて彩度を落としたレイヤーを合成する
+func drawDesaturated(screen, layer *ebiten.Image, g *game.Game) {
+	// 効果の始まりと終わりは彩度を滑らかに変化させる
+	strength := 1.0
+	if fade := 0.3; g.SlowMotionTime < fade {
+		strength = g.SlowMotionTime / fade
+	}
+	saturation := 1.0 - (1.0-config.Current().SlowMotion.Saturation)*strength
+	
+	var cm colorm.ColorM
+	cm.ChangeHSV(0, saturation, 1)
+	// わずかに青みを加える
+	cm.Scale(1.0-0.1*strength, 1.0-0.05*strength, 1.0, 1)
+	colorm.DrawImage(screen, layer, cm, &colorm.DrawImageOptions{})
 }
 
 // drawPlayer はプレイヤーを描画する
 func drawPlayer(screen *ebiten.Image, player *entity.Player, currentTime float64) {
-	// プレイヤーを描画（白い円）
-	ebitenutil.DrawCircle(screen, player.X, player.Y, player.Size, color.RGBA{255, 255, 255, 255})
+	// 無敵中は金色の光をまとう
+	if player.IsInvincible() {
+		pulse := 0.5 + 0.5*math.Sin(currentTime*12)
+		glowColor := color.RGBA{255, 215, 0, uint8(80 + pulse*100)}
+		ebitenutil.DrawCircle(screen, player.X, player.Y, player.Size+4+pulse*3, glowColor)
+	}
+	
+	// プレイヤーを描画（アニメーションの絵がなければ白い円）
+	if img, ok := sprites().AnimationFrame("player", currentTime); ok {
+		drawSprite(screen, img, player.X, player.Y, player.Size*2, player.Size*2, 0, theme.Current().Player)
+	} else {
+		ebitenutil.DrawCircle(screen, player.X, player.Y, player.Size, theme.Current().Player)
+	}
 	
 	// シールドがある場合、プレイヤーの周りにシールドを描画
 	if player.HasShield() {
@@ -67,16 +217,8 @@ func drawPlayer(screen *ebiten.Image, player *entity.Player, currentTime float64
 
 // drawPlayerShield はプレイヤーのシールドを描画する
 func drawPlayerShield(screen *ebiten.Image, player *entity.Player, currentTime float64) {
-	// シールドの色は耐久値によって変化
-	var shieldColor color.RGBA
-	switch player.Shield {
-	case 3:
-		shieldColor = color.RGBA{0, 255, 255, 100} // 明るい水色（半透明）
-	case 2:
-		shieldColor = color.RGBA{100, 200, 255, 100} // やや暗い水色（半透明）
-	case 1:
-		shieldColor = color.RGBA{150, 150, 255, 100} // 紫がかった色（半透明）
-	}
+	// シールドの色は耐久値によって変化（色は配色で決まる）
+	shieldColor := theme.Current().ShieldColor(player.Shield)
 	
 	// シールドを描画（プレイヤーと同じサイズだが、半透明）
 	ebitenutil.DrawCircle(screen, player.X, player.Y, player.Size, shieldColor)
@@ -120,21 +262,36 @@ func drawPlayerShield(screen *ebiten.Image, player *entity.Player, currentTime f
 	}
 	
 	// シールドの耐久値を表示
-	shieldText := fmt.Sprintf("%d", player.Shield)
-	ebitenutil.DebugPrintAt(screen, shieldText, int(player.X)-3, int(player.Y)-3)
-}
-
-// drawBullet は弾を描画する
-func drawBullet(screen *ebiten.Image, bullet *entity.Bullet) {
-	ebitenutil.DrawCircle(screen, bullet.X, bullet.Y, bullet.Size, bullet.Color)
+	drawCenteredLabel(screen, fmt.Sprintf("%d", player.Shield), player.X, player.Y)
 }
 
-// drawShieldItem はシールドアイテムを描画する
-func drawShieldItem(screen *ebiten.Image, shieldItem *entity.ShieldItem) {
-	if !shieldItem.Active {
+// drawItem はアイテムを種類に応じて描画する
+func drawItem(screen *ebiten.Image, item entity.Item) {
+	base := item.Base()
+	if !base.Active || !base.Visible() {
 		return
 	}
 	
+	switch item.Kind() {
+	case entity.ItemShield:
+		drawShieldItem(screen, base)
+	case entity.ItemBombRefill:
+		drawBadgeItem(screen, base, color.RGBA{255, 140, 0, 220}, "B")
+	case entity.ItemSlowMotion:
+		drawSlowMotionItem(screen, base)
+	case entity.ItemMagnet:
+		drawBadgeItem(screen, base, color.RGBA{220, 50, 80, 220}, "M")
+	case entity.ItemScoreGem:
+		drawScoreGemItem(screen, base)
+	case entity.ItemShrink:
+		drawShrinkItem(screen, base)
+	case entity.ItemInvincible:
+		drawInvincibleItem(screen, base)
+	}
+}
+
+// drawShieldItem はシールドアイテムを描画する
+func drawShieldItem(screen *ebiten.Image, shieldItem *entity.ItemBase) {
 	// 外側の輝き
 	glowColor := color.RGBA{0, 255, 255, 100}
 	ebitenutil.DrawCircle(screen, shieldItem.X, shieldItem.Y, 
@@ -161,8 +318,84 @@ func drawShieldItem(screen *ebiten.Image, shieldItem *entity.ShieldItem) {
 	}
 }
 
+// drawBadgeItem は輝く円の中に文字を描いたアイテムを描画する
+func drawBadgeItem(screen *ebiten.Image, item *entity.ItemBase, clr color.RGBA, label string) {
+	glowColor := clr
+	glowColor.A = 90
+	ebitenutil.DrawCircle(screen, item.X, item.Y, item.Size+item.GlowSize, glowColor)
+	ebitenutil.DrawCircle(screen, item.X, item.Y, item.Size, clr)
+	drawCenteredLabel(screen, label, item.X, item.Y)
+}
+
+// drawCenteredLabel は (x, y) を中心に小さな文字を描画する
+func drawCenteredLabel(screen *ebiten.Image, label string, x, y float64) {
+	_, height := text.Measure(label, labelStyle)
+	text.Draw(screen, label, x, y-height/2, labelStyle)
+}
+
+// drawSlowMotionItem はスローモーションアイテム（時計）を描画する
+func drawSlowMotionItem(screen *ebiten.Image, item *entity.ItemBase) {
+	ebitenutil.DrawCircle(screen, item.X, item.Y, item.Size+item.GlowSize, color.RGBA{160, 100, 255, 90})
+	ebitenutil.DrawCircle(screen, item.X, item.Y, item.Size, color.RGBA{120, 60, 220, 220})
+	
+	// ゆっくり回る時計の針
+	hand := item.Size * 0.8
+	handColor := color.RGBA{255, 255, 255, 230}
+	ebitenutil.DrawLine(screen, item.X, item.Y, item.X+math.Cos(item.Angle*0.5)*hand, item.Y+math.Sin(item.Angle*0.5)*hand, handColor)
+	ebitenutil.DrawLine(screen, item.X, item.Y, item.X, item.Y-hand*0.6, handColor)
+}
+
+// drawScoreGemItem は宝石アイテム（回転するひし形）を描画する
+func drawScoreGemItem(screen *ebiten.Image, item *entity.ItemBase) {
+	ebitenutil.DrawCircle(screen, item.X, item.Y, item.Size*0.7+item.GlowSize, color.RGBA{255, 220, 0, 80})
+	
+	gemColor := color.RGBA{255, 230, 80, 255}
+	for i := 0; i < 4; i++ {
+		a1 := item.Angle + float64(i)*math.Pi/2
+		a2 := item.Angle + float64(i+1)*math.Pi/2
+		ebitenutil.DrawLine(screen, item.X+math.Cos(a1)*item.Size, item.Y+math.Sin(a1)*item.Size*0.7,
+			item.X+math.Cos(a2)*item.Size, item.Y+math.Sin(a2)*item.Size*0.7, gemColor)
+	}
+	ebitenutil.DrawCircle(screen, item.X, item.Y, item.Size*0.3, gemColor)
+}
+
+// drawShrinkItem は縮小アイテム（内側へ向かう矢印）を描画する
+func drawShrinkItem(screen *ebiten.Image, item *entity.ItemBase) {
+	ebitenutil.DrawCircle(screen, item.X, item.Y, item.Size+item.GlowSize, color.RGBA{80, 220, 120, 90})
+	ebitenutil.DrawCircle(screen, item.X, item.Y, item.Size*0.4, color.RGBA{80, 220, 120, 255})
+	
+	arrowColor := color.RGBA{200, 255, 200, 230}
+	for i := 0; i < 4; i++ {
+		a := item.Angle + float64(i)*math.Pi/2
+		ebitenutil.DrawLine(screen, item.X+math.Cos(a)*item.Size, item.Y+math.Sin(a)*item.Size,
+			item.X+math.Cos(a)*item.Size*0.5, item.Y+math.Sin(a)*item.Size*0.5, arrowColor)
+	}
+}
+
+// drawInvincibleItem は無敵アイテム（金色の星）を描画する
+func drawInvincibleItem(screen *ebiten.Image, item *entity.ItemBase) {
+	ebitenutil.DrawCircle(screen, item.X, item.Y, item.Size+item.GlowSize, color.RGBA{255, 215, 0, 100})
+	
+	starColor := color.RGBA{255, 240, 120, 255}
+	for i := 0; i < 5; i++ {
+		a1 := item.Angle + float64(i)*math.Pi*0.8
+		a2 := item.Angle + float64(i+1)*math.Pi*0.8
+		ebitenutil.DrawLine(screen, item.X+math.Cos(a1)*item.Size, item.Y+math.Sin(a1)*item.Size,
+			item.X+math.Cos(a2)*item.Size, item.Y+math.Sin(a2)*item.Size, starColor)
+	}
+}
+
 // drawExplosion は爆発エフェクトを描画する
 func drawExplosion(screen *ebiten.Image, explosion *entity.Explosion) {
+	switch explosion.Kind {
+	case config.BombLineSweep:
+		drawLineSweep(screen, explosion)
+		return
+	case config.BombTimeStop:
+		drawTimeStop(screen, explosion)
+		return
+	}
+	
 	// 爆発の円を描画
 	ebitenutil.DrawCircle(screen, explosion.X, explosion.Y, explosion.Radius, explosion.GetColor())
 	
@@ -176,43 +409,60 @@ func drawExplosion(screen *ebiten.Image, explosion *entity.Explosion) {
 	ebitenutil.DrawCircle(screen, explosion.X, explosion.Y, explosion.Radius * 0.3, centerColor)
 }
 
-// drawBombCooldown はボムのクールダウンを表示する
-func drawBombCooldown(screen *ebiten.Image, player *entity.Player) {
-	// クールダウン表示の位置
-	x, y := 20, 40
-	width := 100.0
-	height := 10.0
+// drawLineSweep は掃射ボムの掃射線を描画する
+func drawLineSweep(screen *ebiten.Image, explosion *entity.Explosion) {
+	// 掃射線の後ろに残る光の帯
+	trailHeight := 40.0
+	trailColor := color.RGBA{255, 200, 100, uint8(explosion.Alpha * 80)}
+	ebitenutil.DrawRect(screen, 0, explosion.Radius-trailHeight, float64(config.ScreenWidth), trailHeight, trailColor)
 	
-	// 背景バー
-	ebitenutil.DrawRect(screen, float64(x), float64(y), width, height, color.RGBA{50, 50, 50, 200})
+	// 掃射線本体
+	lineColor := color.RGBA{255, 255, 200, 230}
+	ebitenutil.DrawRect(screen, 0, explosion.Radius-2, float64(config.ScreenWidth), 4, lineColor)
+}
+
+// drawTimeStop は時間停止ボムの効果を描画する
+func drawTimeStop(screen *ebiten.Image, explosion *entity.Explosion) {
+	// 画面全体を青みがかった色で覆う
+	tintColor := color.RGBA{60, 80, 160, uint8(60 * (1.0 - explosion.Progress()*0.5))}
+	ebitenutil.DrawRect(screen, 0, 0, float64(config.ScreenWidth), float64(config.ScreenHeight), tintColor)
 	
-	// クールダウン進行バー
-	if !player.BombAvailable {
-		progress := 1.0 - (player.BombCooldown / player.BombCooldownMax)
-		ebitenutil.DrawRect(screen, float64(x), float64(y), width * progress, height, color.RGBA{0, 200, 255, 200})
-	} else {
-		// 使用可能時は満タン
-		ebitenutil.DrawRect(screen, float64(x), float64(y), width, height, color.RGBA{0, 255, 255, 200})
+	// 広がる波紋
+	if explosion.Radius < explosion.MaxRadius {
+		ringColor := color.RGBA{150, 200, 255, 120}
+		drawRing(screen, explosion.X, explosion.Y, explosion.Radius, ringColor)
+	}
+}
+
+// drawRing は円周を線で描画する
+func drawRing(screen *ebiten.Image, x, y, radius float64, clr color.RGBA) {
+	segments := 48
+	for i := 0; i < segments; i++ {
+		a1 := float64(i) * 2 * math.Pi / float64(segments)
+		a2 := float64(i+1) * 2 * math.Pi / float64(segments)
+		ebitenutil.DrawLine(screen, x+math.Cos(a1)*radius, y+math.Sin(a1)*radius, x+math.Cos(a2)*radius, y+math.Sin(a2)*radius, clr)
+	}
+}
+
+// drawScoreItem は得点アイテムを描画する
+func drawScoreItem(screen *ebiten.Image, item *entity.ScoreItem) {
+	if img, ok := sprites().Frame("score_item"); ok {
+		drawSprite(screen, img, item.X, item.Y, item.Size*3, item.Size*3, 0, color.RGBA{255, 240, 120, 255})
+		return
 	}
 	
-	// テキスト表示
-	bombText := "BOMB [X]"
-	ebitenutil.DebugPrintAt(screen, bombText, x, y-5)
+	ebitenutil.DrawCircle(screen, item.X, item.Y, item.Size+1, color.RGBA{255, 220, 0, 120})
+	ebitenutil.DrawCircle(screen, item.X, item.Y, item.Size, color.RGBA{255, 255, 150, 255})
 }
 
 // drawScoreAnimation はスコアアニメーションを描画する
 func drawScoreAnimation(screen *ebiten.Image, anim *entity.ScoreAnimation) {
 	// スケールと透明度に基づいて描画
-	scoreText := fmt.Sprintf("%.2f seconds", anim.Score)
-	
-	// 文字サイズを計算（スケールに応じて）
-	textWidth := float64(len(scoreText) * 6) * anim.Scale
-	textX := anim.X - textWidth/2
-	
-	// テキストを描画（簡易的なスケーリング）
-	for i := 0; i < int(anim.Scale*2); i++ {
-		ebitenutil.DebugPrintAt(screen, scoreText, int(textX), int(anim.Y)+i)
-	}
+	scoreText := i18n.Seconds(anim.Score)
+	style := scoreStyle
+	style.Scale = anim.Scale
+	style.Transparency = 1 - anim.Alpha
+	text.Draw(screen, scoreText, anim.X, anim.Y, style)
 }
 
 // drawGameOver はゲームオーバー画面を描画する
@@ -222,39 +472,153 @@ func drawGameOver(screen *ebiten.Image, g *game.Game) {
 	ebitenutil.DrawRect(screen, 0, 0, float64(config.ScreenWidth), float64(config.ScreenHeight), overlayColor)
 	
 	// ゲームオーバーテキストを描画（拡大/縮小アニメーション付き）
-	gameOverText := "GAME OVER"
-	textWidth := float64(len(gameOverText) * 8) * g.GameOverScale
-	textX := (float64(config.ScreenWidth) - textWidth) / 2
+	centerX := float64(config.ScreenWidth) / 2
 	textY := float64(config.ScreenHeight)/3 - 20
+	style := headingStyle
+	style.Scale = g.GameOverScale
+	style.Color = color.RGBA{255, 80, 80, 255}
+	heading := i18n.T("gameover.heading")
+	_, headingHeight := text.Measure(heading, style)
+	text.Draw(screen, heading, centerX, textY-headingHeight/2, style)
 	
-	// テキストを複数回描画してボールド効果を出す
-	for i := 0; i < int(g.GameOverScale*3); i++ {
-		ebitenutil.DebugPrintAt(screen, gameOverText, int(textX), int(textY)+i)
+	// リスタート案内
+	restartY := textY + 40
+	text.Draw(screen, i18n.T("gameover.restart"), centerX, restartY, menuStyle)
+	
+	// アシストモードと練習モードの結果はランキングに載らない
+	noteY := restartY + 26
+	if g.Run.Assist {
+		text.Draw(screen, i18n.T("gameover.assist"), centerX, noteY, noteStyle)
+		noteY += 22
+	}
+	if g.Run.Practice {
+		text.Draw(screen, i18n.T("gameover.practice"), centerX, noteY, noteStyle)
+		noteY += 22
+	} else if g.Run.Debugged {
+		text.Draw(screen, i18n.T("gameover.debugged"), centerX, noteY, noteStyle)
+		noteY += 22
+	} else if g.Run.Daily != "" && !g.Run.Ranked() {
+		text.Draw(screen, i18n.T("gameover.daily_unranked"), centerX, noteY, noteStyle)
+		noteY += 22
 	}
 	
-	// リスタート案内
-	restartText := "Press SPACE to restart"
-	restartX := config.ScreenWidth/2 - len(restartText)*3
-	restartY := int(textY) + 40
-	ebitenutil.DebugPrintAt(screen, restartText, restartX, restartY)
+	// このプレイで解除した実績
+	if recent := g.Achievements.Recent(); len(recent) > 0 {
+		names := make([]string, len(recent))
+		for i, id := range recent {
+			names[i] = i18n.T("achievement." + id)
+		}
+		style := noteStyle
+		style.Color = color.RGBA{255, 215, 0, 255}
+		text.Draw(screen, i18n.T("gameover.achievements", strings.Join(names, " / ")), centerX, noteY, style)
+	}
+	
+	// デイリーチャレンジではデイリーチャレンジの記録を表示
+	if g.Run.Daily != "" {
+		drawDailyRanking(screen, g)
+		return
+	}
 	
 	// ランキングを表示（徐々に表示されるアニメーション）
 	if g.RankingAppear > 0 {
-		rankingTitleY := config.ScreenHeight/2 + 30
-		ebitenutil.DebugPrintAt(screen, "TOP SCORES:", config.ScreenWidth/2-50, rankingTitleY)
+		rankingTitleY := float64(config.ScreenHeight)/2 + 30
+		rankingTitle := i18n.T("gameover.ranking", presetName(g.PresetSettings()))
+		text.Draw(screen, rankingTitle, centerX, rankingTitleY, menuStyle)
 		
 		// 各スコアを表示（徐々に表示）
-		maxScoresToShow := int(float64(len(g.Scores)) * g.RankingAppear)
-		for i := 0; i < maxScoresToShow && i < len(g.Scores); i++ {
-			scoreText := fmt.Sprintf("%d. %.2f seconds", i+1, g.Scores[i])
-			
+		scores := g.Rankings.Top(g.Preset)
+		maxScoresToShow := int(float64(len(scores)) * g.RankingAppear)
+		for i := 0; i < maxScoresToShow && i < len(scores); i++ {
 			// アニメーション効果（少しずつ右から現れる）
-			offset := int((1.0 - g.RankingAppear) * 100)
-			if offset < 0 {
-				offset = 0
-			}
+			offset := math.Max(0, (1.0-g.RankingAppear)*100)
+			y := rankingTitleY + 30 + float64(i)*24
 			
-			ebitenutil.DebugPrintAt(screen, scoreText, config.ScreenWidth/2-50+offset, rankingTitleY+20+i*20)
+			// 順位・時間・得点を列に揃えて表示
+			text.Draw(screen, i18n.Rank(i+1), centerX-110+offset, y, rankStyle)
+			text.Draw(screen, i18n.ShortSeconds(scores[i].Time), centerX+20+offset, y, rankStyle)
+			text.Draw(screen, i18n.Points(scores[i].Score), centerX+120+offset, y, rankStyle)
+		}
+	}
+}
+
+// drawTitle はタイトル画面（難易度選択）を描画する
+func drawTitle(screen *ebiten.Image, g *game.Game) {
+	// 背景の弾幕を暗くする
+	ebitenutil.DrawRect(screen, 0, 0, float64(config.ScreenWidth), float64(config.ScreenHeight), color.RGBA{0, 0, 0, 150})
+	
+	// タイトル
+	centerX := float64(config.ScreenWidth) / 2
+	titleStyle := headingStyle
+	titleStyle.Color = color.RGBA{0, 220, 255, 255}
+	text.Draw(screen, i18n.T("title.heading"), centerX, float64(config.ScreenHeight)/4, titleStyle)
+	
+	// 難易度の一覧と各難易度の最高記録
+	listX := centerX - 110
+	listY := float64(config.ScreenHeight)/2 - 40
+	for i, preset := range config.Current().Presets() {
+		kind := config.DifficultyKind(i)
+		y := listY + float64(i)*30
+		
+		if kind == g.TitleSelection {
+			ebitenutil.DrawRect(screen, listX-10, y-4, 240, 26, color.RGBA{0, 200, 255, 80})
+			text.Draw(screen, ">", listX-2, y, menuItemStyle)
 		}
+		
+		bestText := i18n.T("title.no_record")
+		if best, ok := g.Rankings.Best(kind); ok {
+			bestText = i18n.T("title.best", i18n.ShortSeconds(best.Time))
+		}
+		text.Draw(screen, presetName(preset), listX+14, y, menuItemStyle)
+		bestStyle := menuItemStyle
+		bestStyle.Align = text.AlignRight
+		text.Draw(screen, bestText, listX+220, y, bestStyle)
 	}
+	
+	// アシストモードの状態
+	assistText := i18n.T("title.assist_off")
+	if g.Assist {
+		assistText = i18n.T("title.assist_on")
+	}
+	optionsY := listY + config.DifficultyCount*30 + 10
+	text.Draw(screen, assistText, centerX, optionsY, menuStyle)
+	
+	// 今日のデイリーチャレンジ
+	text.Draw(screen, dailyLabel(g), centerX, optionsY+28, menuStyle)
+	
+	// 操作案内
+	text.Draw(screen, i18n.T("title.help"), centerX, optionsY+58, noteStyle)
+}
+
+// drawSettings は設定画面を描画する
+func drawSettings(screen *ebiten.Image, g *game.Game) {
+	// 背景の弾幕を暗くする
+	ebitenutil.DrawRect(screen, 0, 0, float64(config.ScreenWidth), float64(config.ScreenHeight), color.RGBA{0, 0, 0, 180})
+	
+	centerX := float64(config.ScreenWidth) / 2
+	text.Draw(screen, i18n.T("settings.heading"), centerX, float64(config.ScreenHeight)/4, headingStyle)
+	
+	// 項目名を左に、値を右に揃えて表示
+	listX := centerX - 160
+	listY := float64(config.ScreenHeight)/2 - 40
+	valueStyle := menuItemStyle
+	valueStyle.Align = text.AlignRight
+	for i, entry := range g.SettingsEntries() {
+		y := listY + float64(i)*30
+		
+		if i == g.SettingsSelection {
+			ebitenutil.DrawRect(screen, listX-10, y-4, 340, 26, color.RGBA{0, 200, 255, 80})
+			text.Draw(screen, ">", listX-2, y, menuItemStyle)
+		}
+		
+		text.Draw(screen, entry.Label, listX+14, y, menuItemStyle)
+		text.Draw(screen, "< "+entry.Value+" >", listX+320, y, valueStyle)
+	}
+	
+	// 操作案内
+	text.Draw(screen, i18n.T("settings.help"), centerX, listY+float64(len(g.SettingsEntries()))*30+30, noteStyle)
+}
+
+// presetName は難易度プリセットの表示名を返す
+func presetName(preset config.DifficultyPreset) string {
+	return i18n.T("preset." + preset.Name)
 }
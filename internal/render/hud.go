@@ -0,0 +1,286 @@
+package render
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+
+	"game/internal/config"
+	"game/internal/game"
+	"game/internal/hud"
+	"game/internal/i18n"
+	"game/internal/text"
+	"game/internal/theme"
+)
+
+// HUDの1行の高さ
+const lineHeight = 18
+
+// ゲージ系ウィジェットの大きさ
+const (
+	gaugeWidth  = 100
+	gaugeHeight = 10
+)
+
+// hudWidget はHUDウィジェットの大きさの計算と描画
+type hudWidget struct {
+	size func(g *game.Game) (int, int)              // 表示するものがなければ0を返す
+	draw func(screen *ebiten.Image, g *game.Game, x, y int) // (x, y) はウィジェットの左上
+}
+
+// hudWidgets はウィジェットの種類ごとの描画方法
+var hudWidgets = map[string]hudWidget{
+	hud.WidgetTime: textWidget(func(g *game.Game) string {
+		return i18n.T("hud.time", fmt.Sprintf("%.2f", g.CurrentTime))
+	}),
+	hud.WidgetDifficulty: textWidget(func(g *game.Game) string {
+		label := i18n.T("hud.level", presetName(g.PresetSettings()), g.Difficulty)
+		if g.Curve.At(g.Difficulty).Breather {
+			label += i18n.T("hud.break")
+		}
+		if g.Run.Assist {
+			label += i18n.T("hud.assist", g.Director.Factor)
+		}
+		if g.Run.Practice {
+			label += i18n.T("hud.practice")
+		}
+		if g.Run.Daily != "" {
+			label += i18n.T("hud.daily")
+		}
+		return label
+	}),
+	hud.WidgetScore: textWidget(func(g *game.Game) string {
+		return i18n.T("hud.score", g.Score)
+	}),
+	hud.WidgetHiScore: textWidget(func(g *game.Game) string {
+		if best, ok := g.Rankings.Best(g.Preset); ok {
+			return i18n.T("hud.hiscore", fmt.Sprintf("%.2f", best.Time))
+		}
+		return i18n.T("hud.hiscore_none")
+	}),
+	hud.WidgetGraze: textWidget(func(g *game.Game) string {
+		return i18n.T("hud.graze", g.Grazes)
+	}),
+	hud.WidgetFPS: textWidget(func(g *game.Game) string {
+		return i18n.T("hud.fps", ebiten.ActualFPS())
+	}),
+	hud.WidgetLives: {
+		size: func(g *game.Game) (int, int) {
+			if label := livesLabel(g); label != "" {
+				return textWidth(label), lineHeight
+			}
+			return 0, 0
+		},
+		draw: func(screen *ebiten.Image, g *game.Game, x, y int) {
+			style := hudStyle
+			style.Color = theme.Current().HUD.Life
+			text.Draw(screen, livesLabel(g), float64(x), float64(y), style)
+		},
+	},
+	hud.WidgetShield: {
+		size: func(g *game.Game) (int, int) { return pipsWidth(i18n.T("hud.shield"), shieldPips(g)), lineHeight },
+		draw: func(screen *ebiten.Image, g *game.Game, x, y int) {
+			drawPips(screen, x, y, i18n.T("hud.shield"), g.Player.Shield, shieldPips(g), theme.Current().HUD.Shield)
+		},
+	},
+	hud.WidgetBomb: {
+		size: func(g *game.Game) (int, int) {
+			return max(gaugeWidth, textWidth(bombLabel(g))), lineHeight + gaugeHeight
+		},
+		draw: drawBombCooldown,
+	},
+	hud.WidgetSlow: {
+		size: func(g *game.Game) (int, int) { return gaugeWidth, lineHeight + gaugeHeight },
+		draw: drawSlowMotionGauge,
+	},
+	hud.WidgetEffects: {
+		size: func(g *game.Game) (int, int) {
+			lines := activeEffects(g)
+			width := 0
+			for _, line := range lines {
+				width = max(width, textWidth(line))
+			}
+			return width, len(lines) * lineHeight
+		},
+		draw: drawActiveEffects,
+	},
+}
+
+// livesLabel は残機無制限のプレイでのミスの回数の表示を返す
+// 通常のプレイはシールドなしで被弾するとゲームオーバーになり残機がないため、何も表示しない
+func livesLabel(g *game.Game) string {
+	if !g.Run.InfiniteLives {
+		return ""
+	}
+	return i18n.T("hud.lives", g.Misses)
+}
+
+// textWidget は1行の文字列を表示するウィジェットを作成する
+func textWidget(label func(g *game.Game) string) hudWidget {
+	return hudWidget{
+		size: func(g *game.Game) (int, int) { return textWidth(label(g)), lineHeight },
+		draw: func(screen *ebiten.Image, g *game.Game, x, y int) {
+			drawHUDText(screen, label(g), x, y)
+		},
+	}
+}
+
+// textWidth はHUDの文字列の幅を返す
+func textWidth(str string) int {
+	width, _ := text.Measure(str, hudStyle)
+	return int(math.Ceil(width))
+}
+
+// drawHUDText はHUDの文字列を配色の文字色で描画する
+func drawHUDText(screen *ebiten.Image, str string, x, y int) {
+	style := hudStyle
+	style.Color = theme.Current().HUD.Text
+	text.Draw(screen, str, float64(x), float64(y), style)
+}
+
+// dim はゲージの溜まっている途中の表示に使う、少し暗くした色を返す
+func dim(clr color.RGBA) color.RGBA {
+	return color.RGBA{uint8(float64(clr.R) * 0.7), uint8(float64(clr.G) * 0.7), uint8(float64(clr.B) * 0.7), clr.A}
+}
+
+// arrangeHUD は現在のHUDレイアウトに従ってウィジェットの位置をキャンバス座標で決める
+func arrangeHUD(g *game.Game) []hud.Placement {
+	left, right := g.Viewport.SidePanels()
+	areas := map[hud.Region]image.Rectangle{
+		hud.RegionOverlay: g.Viewport.PlayfieldRect(),
+		hud.RegionLeft:    left,
+		hud.RegionRight:   right,
+	}
+	return hud.Current().Arrange(areas, func(name string) (int, int) {
+		return hudWidgets[name].size(g)
+	})
+}
+
+// drawHUD はHUDウィジェットを描画する
+// overlay が true ならプレイフィールド（論理座標）に重ねるウィジェットを、false ならサイドパネルのウィジェットをキャンバスに描く
+func drawHUD(screen *ebiten.Image, g *game.Game, overlay bool) {
+	origin := image.Point{}
+	if overlay {
+		origin = g.Viewport.PlayfieldRect().Min
+	}
+
+	for _, p := range arrangeHUD(g) {
+		if (p.Region == hud.RegionOverlay) != overlay {
+			continue
+		}
+		pos := p.Rect.Min.Sub(origin)
+		hudWidgets[p.Widget.Name].draw(screen, g, pos.X, pos.Y)
+	}
+}
+
+// shieldPips はシールドの表示に使う目盛りの数を返す
+func shieldPips(g *game.Game) int {
+	return max(config.Current().Shield.Durability, g.Player.Shield)
+}
+
+// pipsWidth は目盛り付きのウィジェットの幅を返す
+func pipsWidth(label string, total int) int {
+	return textWidth(label) + 6 + total*12
+}
+
+// drawPips はラベルと、filled個だけ塗りつぶした目盛りを描画する
+func drawPips(screen *ebiten.Image, x, y int, label string, filled, total int, clr color.RGBA) {
+	drawHUDText(screen, label, x, y)
+	pipX := float64(x + textWidth(label) + 6)
+	for i := 0; i < total; i++ {
+		px := pipX + float64(i*12)
+		if i < filled {
+			ebitenutil.DrawRect(screen, px, float64(y+4), 8, 8, clr)
+		} else {
+			ebitenutil.DrawRect(screen, px, float64(y+4), 8, 8, theme.Current().HUD.GaugeBack)
+		}
+	}
+}
+
+// bombLabel はボムのゲージの見出しを返す
+func bombLabel(g *game.Game) string {
+	return i18n.T("hud.bomb", i18n.T("bomb."+config.Current().Bomb(g.Player.BombKind).Name))
+}
+
+// drawBombCooldown はボムのクールダウンを表示する
+func drawBombCooldown(screen *ebiten.Image, g *game.Game, x, y int) {
+	player := g.Player
+	drawHUDText(screen, bombLabel(g), x, y)
+	barY := float64(y + lineHeight)
+
+	// 背景バー
+	colors := theme.Current().HUD
+	ebitenutil.DrawRect(screen, float64(x), barY, gaugeWidth, gaugeHeight, colors.GaugeBack)
+
+	// クールダウン進行バー（使用可能になるまでは少し暗く表示）
+	if !player.BombAvailable {
+		progress := cooldownProgress(player.BombCooldown, player.BombCooldownMax)
+		ebitenutil.DrawRect(screen, float64(x), barY, gaugeWidth * progress, gaugeHeight, dim(colors.Bomb))
+	} else {
+		// 使用可能時は満タン
+		ebitenutil.DrawRect(screen, float64(x), barY, gaugeWidth, gaugeHeight, colors.Bomb)
+	}
+}
+
+// cooldownProgress はクールダウンの進み具合（0〜1）を返す（最大時間が0なら溜まりきっているものとする）
+func cooldownProgress(remaining, maximum float64) float64 {
+	if maximum <= 0 {
+		return 1.0
+	}
+	return 1.0 - remaining/maximum
+}
+
+// drawSlowMotionGauge はスローモーションの残り時間とスロースキルのクールダウンを表示する
+func drawSlowMotionGauge(screen *ebiten.Image, g *game.Game, x, y int) {
+	drawHUDText(screen, i18n.T("hud.slow"), x, y)
+	barY := float64(y + lineHeight)
+
+	// 背景バー
+	colors := theme.Current().HUD
+	ebitenutil.DrawRect(screen, float64(x), barY, gaugeWidth, gaugeHeight, colors.GaugeBack)
+
+	switch {
+	case g.SlowMotionTime > 0:
+		// 効果中は残り時間を表示
+		remaining := g.SlowMotionTime / g.SlowMotionDuration
+		ebitenutil.DrawRect(screen, float64(x), barY, gaugeWidth * remaining, gaugeHeight, colors.Slow)
+	case !g.Player.SlowSkillAvailable:
+		// クールダウン進行バー
+		progress := cooldownProgress(g.Player.SlowSkillCooldown, g.Player.SlowSkillCooldownMax)
+		ebitenutil.DrawRect(screen, float64(x), barY, gaugeWidth * progress, gaugeHeight, dim(colors.Slow))
+	default:
+		// 使用可能時は満タン
+		ebitenutil.DrawRect(screen, float64(x), barY, gaugeWidth, gaugeHeight, colors.Slow)
+	}
+}
+
+// activeEffects はプレイヤーにかかっているアイテム効果の残り時間を1行ずつ返す
+func activeEffects(g *game.Game) []string {
+	effects := []struct {
+		key       string // メッセージのキー
+		remaining float64
+	}{
+		{"effect.magnet", g.Player.MagnetTime},
+		{"effect.shrink", g.Player.ShrinkTime},
+		{"effect.invincible", g.Player.InvincibleTime},
+	}
+
+	lines := make([]string, 0, len(effects))
+	for _, e := range effects {
+		if e.remaining > 0 {
+			lines = append(lines, i18n.T(e.key, e.remaining))
+		}
+	}
+	return lines
+}
+
+// drawActiveEffects はプレイヤーにかかっているアイテム効果の残り時間を表示する
+func drawActiveEffects(screen *ebiten.Image, g *game.Game, x, y int) {
+	for i, line := range activeEffects(g) {
+		drawHUDText(screen, line, x, y+i*lineHeight)
+	}
+}
@@ -0,0 +1,41 @@
+package render
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"game/internal/particle"
+)
+
+// particleTexture は中心から外側へ透明になる白い円（粒子の色は頂点の色で付ける）
+var particleTexture *ebiten.Image
+
+// particleBatch は粒子の頂点バッファ（毎フレーム使い回す）
+var particleBatch quadBatch
+
+// drawParticles は粒子を描画する
+// 通常の合成と加算合成の粒子をそれぞれ1回の DrawTriangles でまとめて描く
+func drawParticles(screen *ebiten.Image, system *particle.System) {
+	if particleTexture == nil {
+		particleTexture = newDiscTexture(16, 1)
+	}
+	
+	particles := system.Particles()
+	for _, additive := range []bool{false, true} {
+		particleBatch.reset()
+		for i := range particles {
+			p := &particles[i]
+			if p.Additive != additive {
+				continue
+			}
+			// 粒子の大きさを半径として、テクスチャの円がその大きさになるように広げる
+			size := p.Size() * 4
+			particleBatch.add(p.X, p.Y, size, size, 0, particleTexture.Bounds(), p.Color())
+		}
+		
+		blend := ebiten.BlendSourceOver
+		if additive {
+			blend = ebiten.BlendLighter
+		}
+		particleBatch.flush(screen, particleTexture, blend)
+	}
+}
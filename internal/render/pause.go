@@ -0,0 +1,86 @@
+package render
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+
+	"game/internal/config"
+	"game/internal/entity"
+	"game/internal/i18n"
+	"game/internal/text"
+)
+
+// 凡例の見本の弾の速さ（1フレームあたりのピクセル、速さの段階ごと）
+var legendSpeeds = map[entity.SpeedTier]float64{
+	entity.SpeedSlow:   2,
+	entity.SpeedMedium: 4,
+	entity.SpeedFast:   6,
+}
+
+// 凡例の危険度の見本にする弾の種類と速さの段階
+var legendDangers = []struct {
+	danger entity.Danger
+	kind   entity.BulletKind
+	tier   entity.SpeedTier
+}{
+	{entity.DangerLow, entity.BulletPlain, entity.SpeedSlow},
+	{entity.DangerMedium, entity.BulletAimed, entity.SpeedMedium},
+	{entity.DangerHigh, entity.BulletHoming, entity.SpeedMedium},
+}
+
+// legendDescStyle は凡例の説明文の描画方法
+var legendDescStyle = text.Style{Size: 14, Color: color.RGBA{180, 180, 200, 255}, Shadow: 1}
+
+// drawPause は一時停止画面を弾の凡例とともに描画する
+// 凡例の見本はプレイ中と同じ DrawBullets で描くため、配色を変えると見本の色も変わる
+func drawPause(screen *ebiten.Image) {
+	// 背景のプレイ画面を暗くする
+	ebitenutil.DrawRect(screen, 0, 0, float64(config.ScreenWidth), float64(config.ScreenHeight), color.RGBA{0, 0, 0, 190})
+	
+	centerX := float64(config.ScreenWidth) / 2
+	text.Draw(screen, i18n.T("pause.heading"), centerX, 50, headingStyle)
+	text.Draw(screen, i18n.T("pause.legend"), centerX, 120, menuStyle)
+	
+	size := config.Current().Bullet.Size
+	sampleX := centerX - 230
+	var samples []*entity.Bullet
+	
+	// 振る舞いの種類ごとの色と説明
+	y := 175.0
+	for _, kind := range entity.BulletKinds {
+		samples = append(samples, entity.NewKindBullet(kind, sampleX, y, legendSpeeds[entity.SpeedMedium], 0, size))
+		drawLegendLabel(screen, i18n.T("bullet."+kind.String()), sampleX+25, y, menuItemStyle)
+		drawLegendLabel(screen, i18n.T("bullet."+kind.String()+"_desc"), centerX-60, y, legendDescStyle)
+		y += 40
+	}
+	
+	// 速さの段階ごとの明るさと形
+	y += 15
+	drawLegendLabel(screen, i18n.T("pause.speed"), sampleX-10, y, menuItemStyle)
+	for i, tier := range entity.SpeedTiers {
+		x := centerX - 60 + float64(i)*130
+		samples = append(samples, entity.NewKindBullet(entity.BulletPlain, x, y, legendSpeeds[tier], 0, size))
+		drawLegendLabel(screen, i18n.T("speed."+tier.String()), x+25, y, legendDescStyle)
+	}
+	
+	// 危険度ごとの周りの光
+	y += 45
+	drawLegendLabel(screen, i18n.T("pause.danger"), sampleX-10, y, menuItemStyle)
+	for i, d := range legendDangers {
+		x := centerX - 60 + float64(i)*130
+		samples = append(samples, entity.NewKindBullet(d.kind, x, y, legendSpeeds[d.tier], 0, size))
+		drawLegendLabel(screen, i18n.T("danger."+d.danger.String()), x+25, y, legendDescStyle)
+	}
+	
+	DrawBullets(screen, samples)
+	
+	text.Draw(screen, i18n.T("pause.help"), centerX, float64(config.ScreenHeight)-60, noteStyle)
+}
+
+// drawLegendLabel は文字列を (x, y) を左端の縦の中央として描画する
+func drawLegendLabel(screen *ebiten.Image, label string, x, y float64, style text.Style) {
+	_, height := text.Measure(label, style)
+	text.Draw(screen, label, x, y-height/2, style)
+}
@@ -0,0 +1,47 @@
+package render
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+
+	"game/internal/config"
+	"game/internal/game"
+	"game/internal/i18n"
+	"game/internal/text"
+)
+
+// drawPractice は練習モードの設定画面を描画する
+func drawPractice(screen *ebiten.Image, g *game.Game) {
+	// 背景の弾幕を暗くする
+	ebitenutil.DrawRect(screen, 0, 0, float64(config.ScreenWidth), float64(config.ScreenHeight), color.RGBA{0, 0, 0, 180})
+	
+	centerX := float64(config.ScreenWidth) / 2
+	text.Draw(screen, i18n.T("practice.heading"), centerX, float64(config.ScreenHeight)/4, headingStyle)
+	
+	// 項目名を左に、値を右に揃えて表示
+	listX := centerX - 160
+	listY := float64(config.ScreenHeight)/2 - 60
+	valueStyle := menuItemStyle
+	valueStyle.Align = text.AlignRight
+	entries := g.PracticeEntries()
+	for i, entry := range entries {
+		y := listY + float64(i)*30
+		
+		if i == g.PracticeSelection {
+			ebitenutil.DrawRect(screen, listX-10, y-4, 340, 26, color.RGBA{0, 200, 255, 80})
+			text.Draw(screen, ">", listX-2, y, menuItemStyle)
+		}
+		
+		text.Draw(screen, entry.Label, listX+14, y, menuItemStyle)
+		text.Draw(screen, "< "+entry.Value+" >", listX+320, y, valueStyle)
+	}
+	
+	// 練習モードの結果はランキングに載らない
+	noteY := listY + float64(len(entries))*30 + 20
+	text.Draw(screen, i18n.T("practice.note"), centerX, noteY, noteStyle)
+	
+	// 操作案内
+	text.Draw(screen, i18n.T("practice.help"), centerX, noteY+24, noteStyle)
+}
@@ -0,0 +1,58 @@
+package render
+
+import (
+	"image/color"
+	"log/slog"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"game/internal/entity"
+	"game/internal/sprite"
+)
+
+// atlas は組み込みのスプライト（読み込めなかった場合はnilで、すべて図形で描画する）
+var atlas *sprite.Atlas
+
+// atlasLoaded はスプライトの読み込みを試みたかどうか
+var atlasLoaded bool
+
+// sprites はスプライトを返す（初回の呼び出しで読み込む）
+func sprites() *sprite.Atlas {
+	if !atlasLoaded {
+		atlasLoaded = true
+		a, err := sprite.Load()
+		if err != nil {
+			slog.Warn("スプライトを読み込めないため図形で描画します", "err", err)
+		}
+		atlas = a
+	}
+	return atlas
+}
+
+// drawSprite は絵を (x, y) を中心に、幅 w・高さ h に拡大縮小し、angle だけ回転させ、色を掛けて描画する
+func drawSprite(screen, img *ebiten.Image, x, y, w, h, angle float64, clr color.Color) {
+	bounds := img.Bounds()
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(-float64(bounds.Dx())/2, -float64(bounds.Dy())/2)
+	op.GeoM.Scale(w/float64(bounds.Dx()), h/float64(bounds.Dy()))
+	op.GeoM.Rotate(angle)
+	op.GeoM.Translate(x, y)
+	op.ColorScale.ScaleWithColor(clr)
+	op.Filter = ebiten.FilterLinear
+	screen.DrawImage(img, op)
+}
+
+// bulletSprite は弾の種類に応じた絵の名前と、弾の大きさに対する絵の幅・高さを返す
+// 高速の弾は細長い絵で描く
+func bulletSprite(b *entity.Bullet) (name string, w, h float64) {
+	if b.SpeedTier() == entity.SpeedFast {
+		return "bullet_needle", b.Size * 3, b.Size * 1.2
+	}
+	return "bullet", b.Size * 2, b.Size * 2
+}
+
+// bulletAngle は弾の進行方向の角度を返す
+func bulletAngle(b *entity.Bullet) float64 {
+	return math.Atan2(b.VY, b.VX)
+}
@@ -0,0 +1,92 @@
+package spawn
+
+import (
+	"math"
+	"math/rand"
+
+	"game/internal/config"
+	"game/internal/entity"
+)
+
+// Placer は弾やプレイヤーから安全な出現位置を選ぶ配置サービス
+// アイテムに限らず、敵や復活位置など画面内に何かを置く処理で共通して使う
+type Placer struct {
+	Rand              *rand.Rand // 候補位置の抽出に使う乱数
+	Width, Height     float64
+	Candidates        int     // 1回の配置で評価する候補位置の数
+	MinBulletDistance float64 // 弾（予測位置を含む）からの最低距離
+	MinPlayerDistance float64 // プレイヤーからの最低距離
+	PredictFrames     int     // 弾の動きを予測するフレーム数
+	PredictStep       int     // 予測で位置を調べるフレーム間隔
+	DensityRadius     float64 // 弾の密度を数える範囲
+}
+
+// NewPlacer は現在の設定に基づいた配置サービスを作成する（候補位置は rng で抽出する）
+func NewPlacer(width, height float64, rng *rand.Rand) *Placer {
+	p := &Placer{Rand: rng, Width: width, Height: height}
+	p.Configure(config.Current().Spawn)
+	return p
+}
+
+// Configure は出現位置の選び方の設定を反映する
+func (p *Placer) Configure(c config.SpawnConfig) {
+	p.Candidates = c.Candidates
+	p.MinBulletDistance = c.MinBulletDistance
+	p.MinPlayerDistance = c.MinPlayerDistance
+	p.PredictFrames = int(math.Round(c.PredictSeconds / config.DeltaTime))
+	p.PredictStep = c.PredictStep
+	p.DensityRadius = c.DensityRadius
+}
+
+// Place は候補位置を抽出して評価し、最も安全な位置を返す
+// 安全な候補が見つからなかった場合、okはfalseになる
+// timeScaleは弾の時間の流れの速さ（スローモーション中の予測に使う）
+func (p *Placer) Place(size float64, player *entity.Player, bullets []*entity.Bullet, timeScale float64) (x, y float64, ok bool) {
+	bestScore := math.Inf(-1)
+	for i := 0; i < p.Candidates; i++ {
+		cx := p.Rand.Float64()*(p.Width-2*size) + size
+		cy := p.Rand.Float64()*(p.Height-2*size) + size
+		
+		score, safe := p.evaluate(cx, cy, size, player, bullets, timeScale)
+		if safe && score > bestScore {
+			bestScore = score
+			x, y = cx, cy
+			ok = true
+		}
+	}
+	return x, y, ok
+}
+
+// evaluate は候補位置の安全度を採点し、配置してよい位置かどうかを返す
+func (p *Placer) evaluate(x, y, size float64, player *entity.Player, bullets []*entity.Bullet, timeScale float64) (float64, bool) {
+	// プレイヤーの真下や目の前には置かない
+	playerDistance := math.Hypot(x-player.X, y-player.Y) - player.Size - size
+	if playerDistance < p.MinPlayerDistance {
+		return 0, false
+	}
+	
+	// 現在から予測時間までの弾の位置を調べる
+	nearest := math.Inf(1)
+	density := 0
+	for _, b := range bullets {
+		for frame := 0; frame <= p.PredictFrames; frame += p.PredictStep {
+			t := float64(frame) * timeScale
+			bx := b.X + b.VX*t
+			by := b.Y + b.VY*t
+			d := math.Hypot(x-bx, y-by) - b.Size - size
+			
+			nearest = math.Min(nearest, d)
+			if d < p.DensityRadius {
+				density++
+			}
+		}
+	}
+	
+	if nearest < p.MinBulletDistance {
+		return 0, false
+	}
+	
+	// 弾から遠く、弾の通り道になりにくく、プレイヤーから程よく離れた位置ほど高得点
+	score := math.Min(nearest, 200) - float64(density)*20 + math.Min(playerDistance, 300)*0.2
+	return score, true
+}
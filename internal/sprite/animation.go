@@ -0,0 +1,22 @@
+package sprite
+
+// Animation はコマの名前を並べたスプライトシートのアニメーション
+type Animation struct {
+	Frames []string `json:"frames"`
+	FPS    float64  `json:"fps"`
+	Loop   bool     `json:"loop"` // false なら最後のコマで止まる
+}
+
+// FrameAt は開始から t 秒後のコマの名前を返す
+func (a *Animation) FrameAt(t float64) string {
+	index := int(t * a.FPS)
+	if index < 0 {
+		index = 0
+	}
+	if a.Loop {
+		index %= len(a.Frames)
+	} else if index >= len(a.Frames) {
+		index = len(a.Frames) - 1
+	}
+	return a.Frames[index]
+}
@@ -0,0 +1,119 @@
+package sprite
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"image"
+	"io/fs"
+	_ "image/png"
+	"path"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// assets は組み込みの画像と、その切り出し方の定義
+//
+//go:embed assets
+var assets embed.FS
+
+// FrameRect はアトラス画像上の1枚の絵の範囲
+type FrameRect struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	W int `json:"w"`
+	H int `json:"h"`
+}
+
+// atlasFile はアトラスの定義ファイルの形式
+type atlasFile struct {
+	Image      string                `json:"image"`
+	Frames     map[string]FrameRect  `json:"frames"`
+	Animations map[string]*Animation `json:"animations"`
+}
+
+// Atlas は1枚の画像にまとめた絵（テクスチャアトラス）
+type Atlas struct {
+	image      *ebiten.Image
+	frames     map[string]*ebiten.Image
+	animations map[string]*Animation
+}
+
+// Load は組み込みのアトラスを読み込む
+func Load() (*Atlas, error) {
+	return LoadFS(assets, "assets/atlas.json")
+}
+
+// LoadFS はファイルシステム上の定義ファイルと、そこから参照される画像を読み込む
+func LoadFS(fsys fs.FS, file string) (*Atlas, error) {
+	data, err := fs.ReadFile(fsys, file)
+	if err != nil {
+		return nil, err
+	}
+	var def atlasFile
+	if err := json.Unmarshal(data, &def); err != nil {
+		return nil, fmt.Errorf("%s: アトラスの定義を解析できません: %w", file, err)
+	}
+
+	imageData, err := fs.ReadFile(fsys, path.Join(path.Dir(file), def.Image))
+	if err != nil {
+		return nil, err
+	}
+	decoded, _, err := image.Decode(bytes.NewReader(imageData))
+	if err != nil {
+		return nil, fmt.Errorf("%s: 画像を読み込めません: %w", def.Image, err)
+	}
+
+	a := &Atlas{
+		image:      ebiten.NewImageFromImage(decoded),
+		frames:     make(map[string]*ebiten.Image, len(def.Frames)),
+		animations: def.Animations,
+	}
+	bounds := a.image.Bounds()
+	for name, r := range def.Frames {
+		rect := image.Rect(r.X, r.Y, r.X+r.W, r.Y+r.H)
+		if r.W <= 0 || r.H <= 0 || !rect.In(bounds) {
+			return nil, fmt.Errorf("%s: 絵 %s の範囲 %v が画像 %v の外にあります", file, name, rect, bounds)
+		}
+		a.frames[name] = a.image.SubImage(rect).(*ebiten.Image)
+	}
+	for name, anim := range a.animations {
+		if len(anim.Frames) == 0 || anim.FPS <= 0 {
+			return nil, fmt.Errorf("%s: アニメーション %s にはコマと正の fps が必要です", file, name)
+		}
+		for _, frame := range anim.Frames {
+			if _, ok := a.frames[frame]; !ok {
+				return nil, fmt.Errorf("%s: アニメーション %s のコマ %s がありません", file, name, frame)
+			}
+		}
+	}
+	return a, nil
+}
+
+// Texture はすべての絵をまとめたアトラス画像を返す
+// 絵の範囲は Frame で得た画像の Bounds で、頂点バッファでまとめて描く場合に使う
+func (a *Atlas) Texture() *ebiten.Image {
+	return a.image
+}
+
+// Frame は名前の絵を返す（なければ false）
+func (a *Atlas) Frame(name string) (*ebiten.Image, bool) {
+	if a == nil {
+		return nil, false
+	}
+	img, ok := a.frames[name]
+	return img, ok
+}
+
+// AnimationFrame は名前のアニメーションの、開始から t 秒後のコマを返す（なければ false）
+func (a *Atlas) AnimationFrame(name string, t float64) (*ebiten.Image, bool) {
+	if a == nil {
+		return nil, false
+	}
+	anim, ok := a.animations[name]
+	if !ok {
+		return nil, false
+	}
+	return a.Frame(anim.FrameAt(t))
+}
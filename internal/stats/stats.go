@@ -0,0 +1,81 @@
+package stats
+
+import "game/internal/event"
+
+// Counters はプレイ中の出来事の回数
+type Counters struct {
+	BulletsCleared int     // ボムで消した弾の数
+	Grazes         int
+	ItemsPicked    int     // 取ったアイテムの数（シールドを含む）
+	ShieldsPicked  int
+	ShieldHits     int     // シールドで防いだ弾の数
+	BombsUsed      int
+	Deaths         int
+	MaxLevel       int     // 到達した最高の難易度レベル
+	BestTime       float64 // 最長の生存時間（秒）
+}
+
+// Stats は現在のプレイと起動してからの通算の統計
+type Stats struct {
+	Run   Counters // 現在のプレイ（BeginRun で0に戻る）
+	Total Counters // 起動してからの通算（ランキング対象のプレイだけを数える）
+	
+	counted bool // 現在のプレイを通算に数えるかどうか
+}
+
+// New は空の統計を作成する
+func New() *Stats {
+	return &Stats{counted: true}
+}
+
+// BeginRun は新しいプレイの統計を始める
+// counted が false のプレイ（練習モードなど）は現在のプレイだけを数え、通算には加えない
+func (s *Stats) BeginRun(counted bool) {
+	s.Run = Counters{}
+	s.counted = counted
+}
+
+// Exclude は現在のプレイをこれ以降、通算に数えないようにする（デバッグ用の操作でプレイの内容を変えた場合など）
+func (s *Stats) Exclude() {
+	s.counted = false
+}
+
+// Subscribe は出来事を数える購読者を Bus に登録する
+func (s *Stats) Subscribe(bus *event.Bus) {
+	event.Subscribe(bus, func(e event.BulletCleared) {
+		s.add(func(c *Counters) { c.BulletsCleared += len(e.Bullets) })
+	})
+	event.Subscribe(bus, func(e event.Grazed) {
+		s.add(func(c *Counters) { c.Grazes++ })
+	})
+	event.Subscribe(bus, func(e event.ItemPicked) {
+		s.add(func(c *Counters) { c.ItemsPicked++ })
+	})
+	event.Subscribe(bus, func(e event.ShieldPicked) {
+		s.add(func(c *Counters) { c.ShieldsPicked++ })
+	})
+	event.Subscribe(bus, func(e event.ShieldHit) {
+		s.add(func(c *Counters) { c.ShieldHits++ })
+	})
+	event.Subscribe(bus, func(e event.BombUsed) {
+		s.add(func(c *Counters) { c.BombsUsed++ })
+	})
+	event.Subscribe(bus, func(e event.DifficultyUp) {
+		s.add(func(c *Counters) { c.MaxLevel = max(c.MaxLevel, e.Level) })
+	})
+	event.Subscribe(bus, func(e event.PlayerDied) {
+		s.add(func(c *Counters) {
+			c.Deaths++
+			c.MaxLevel = max(c.MaxLevel, e.Difficulty)
+			c.BestTime = max(c.BestTime, e.Time)
+		})
+	})
+}
+
+// add は現在のプレイの回数と、通算に数えるプレイなら通算の回数を更新する
+func (s *Stats) add(update func(c *Counters)) {
+	update(&s.Run)
+	if s.counted {
+		update(&s.Total)
+	}
+}
@@ -0,0 +1,80 @@
+package stats
+
+import (
+	"testing"
+
+	"game/internal/entity"
+	"game/internal/event"
+)
+
+func TestCounters(t *testing.T) {
+	s := New()
+	bus := event.NewBus()
+	s.Subscribe(bus)
+
+	s.BeginRun(true)
+	bus.Emit(event.Grazed{})
+	bus.Emit(event.Grazed{})
+	bus.Emit(event.BulletCleared{Bullets: make([]*entity.Bullet, 3)})
+	bus.Emit(event.ItemPicked{})
+	bus.Emit(event.ShieldPicked{})
+	bus.Emit(event.ShieldHit{})
+	bus.Emit(event.BombUsed{})
+	bus.Emit(event.DifficultyUp{Level: 4})
+	bus.Emit(event.PlayerDied{Time: 30, Difficulty: 3})
+
+	want := Counters{
+		BulletsCleared: 3, Grazes: 2, ItemsPicked: 1, ShieldsPicked: 1, ShieldHits: 1,
+		BombsUsed: 1, Deaths: 1, MaxLevel: 4, BestTime: 30,
+	}
+	if s.Run != want {
+		t.Errorf("Run = %+v, want %+v", s.Run, want)
+	}
+	if s.Total != want {
+		t.Errorf("Total = %+v, want %+v", s.Total, want)
+	}
+
+	// 次のプレイでは Run だけが0に戻り、通算は最大値と合計を保つ
+	s.BeginRun(true)
+	bus.Emit(event.PlayerDied{Time: 10, Difficulty: 6})
+	if s.Run != (Counters{Deaths: 1, MaxLevel: 6, BestTime: 10}) {
+		t.Errorf("Run = %+v", s.Run)
+	}
+	if s.Total.Deaths != 2 || s.Total.MaxLevel != 6 || s.Total.BestTime != 30 || s.Total.Grazes != 2 {
+		t.Errorf("Total = %+v", s.Total)
+	}
+}
+
+func TestUncountedRuns(t *testing.T) {
+	tests := []struct {
+		name  string
+		begin func(s *Stats)
+	}{
+		{"対象外のプレイ", func(s *Stats) { s.BeginRun(false) }},
+		{"途中で対象外になったプレイ", func(s *Stats) { s.BeginRun(true); s.Exclude() }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New()
+			bus := event.NewBus()
+			s.Subscribe(bus)
+
+			tt.begin(s)
+			bus.Emit(event.BombUsed{})
+			bus.Emit(event.PlayerDied{Time: 99})
+			if s.Run.BombsUsed != 1 || s.Run.BestTime != 99 {
+				t.Errorf("現在のプレイを数えていません: %+v", s.Run)
+			}
+			if s.Total != (Counters{}) {
+				t.Errorf("対象外のプレイを通算に数えました: %+v", s.Total)
+			}
+
+			// 次の対象のプレイからはまた通算に数える
+			s.BeginRun(true)
+			bus.Emit(event.BombUsed{})
+			if s.Total.BombsUsed != 1 {
+				t.Errorf("Total.BombsUsed = %d, want 1", s.Total.BombsUsed)
+			}
+		})
+	}
+}
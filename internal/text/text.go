@@ -0,0 +1,158 @@
+package text
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	etext "github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+// 組み込みのフォント（ライセンスは assets/LICENSE.md）
+var (
+	//go:embed assets/mplus-1p-regular.ttf
+	mplus1pRegularTTF []byte
+	
+	//go:embed assets/pressstart2p.ttf
+	pressStart2PTTF []byte
+)
+
+// Font は文字の描画に使うフォントの種類
+type Font int
+
+// フォントの種類
+const (
+	FontRegular Font = iota // 本文用（M+ 1p、日本語を含む）
+	FontDisplay             // 見出し用のドット風フォント（Press Start 2P、英数字のみで日本語は本文用で代用）
+)
+
+// Align は文字列の横方向の揃え位置
+type Align int
+
+// 文字列の揃え位置
+const (
+	AlignLeft   Align = iota // (x, y) を左上とする
+	AlignCenter              // (x, y) を上辺の中央とする
+	AlignRight               // (x, y) を右上とする
+)
+
+// Style は文字列の描画方法
+type Style struct {
+	Font    Font
+	Size    float64     // 文字の大きさ（ピクセル）
+	Scale   float64     // 大きさの倍率（0なら1倍）。フォントの大きさ自体を変えるので拡大しても文字がぼやけない
+	Align   Align
+	Color   color.Color // nilなら白
+	Transparency float64 // 透明度（0なら不透明、1なら完全に透明）
+	Outline float64     // 縁取りの太さ（0なら縁取りなし）
+	OutlineColor color.Color
+	Shadow  float64     // 影をずらす量（0なら影なし）
+	ShadowColor color.Color
+}
+
+// faceSources はフォントの種類ごとの読み込み済みのフォントデータ
+var faceSources = map[Font]*etext.GoTextFaceSource{}
+
+func init() {
+	embedded := map[Font][]byte{
+		FontRegular: mplus1pRegularTTF,
+		FontDisplay: pressStart2PTTF,
+	}
+	for kind, ttf := range embedded {
+		source, err := etext.NewGoTextFaceSource(bytes.NewReader(ttf))
+		if err != nil {
+			panic(fmt.Sprintf("組み込みのフォントを読み込めません: %v", err))
+		}
+		faceSources[kind] = source
+	}
+}
+
+// face は描画方法に合ったフォントフェイスを返す
+// 見出し用フォントにない文字（日本語など）は本文用フォントで描く
+func (s Style) face() etext.Face {
+	primary := &etext.GoTextFace{
+		Source: faceSources[s.Font],
+		Size:   s.size(),
+	}
+	if s.Font == FontRegular {
+		return primary
+	}
+	
+	fallback := &etext.GoTextFace{
+		Source: faceSources[FontRegular],
+		Size:   s.size(),
+	}
+	face, err := etext.NewMultiFace(primary, fallback)
+	if err != nil {
+		return primary
+	}
+	return face
+}
+
+// size は倍率を掛けた文字の大きさを返す
+func (s Style) size() float64 {
+	if s.Scale == 0 {
+		return s.Size
+	}
+	return s.Size * s.Scale
+}
+
+// Measure は文字列を描画したときの幅と高さを返す（改行を含む場合は複数行分）
+func Measure(str string, style Style) (float64, float64) {
+	return etext.Measure(str, style.face(), lineSpacing(style))
+}
+
+// Draw は文字列を描画する
+// 影、縁取り、本体の順に重ねて描く
+func Draw(dst *ebiten.Image, str string, x, y float64, style Style) {
+	face := style.face()
+
+	if style.Shadow > 0 {
+		drawLayer(dst, str, face, x+style.Shadow, y+style.Shadow, style, orDefault(style.ShadowColor, color.RGBA{0, 0, 0, 160}))
+	}
+
+	if style.Outline > 0 {
+		outlineColor := orDefault(style.OutlineColor, color.Black)
+		o := style.Outline
+		offsets := [][2]float64{{-o, -o}, {0, -o}, {o, -o}, {-o, 0}, {o, 0}, {-o, o}, {0, o}, {o, o}}
+		for _, d := range offsets {
+			drawLayer(dst, str, face, x+d[0], y+d[1], style, outlineColor)
+		}
+	}
+
+	drawLayer(dst, str, face, x, y, style, orDefault(style.Color, color.White))
+}
+
+// drawLayer は文字列を指定した色で1回描画する
+func drawLayer(dst *ebiten.Image, str string, face etext.Face, x, y float64, style Style, clr color.Color) {
+	op := &etext.DrawOptions{}
+	op.GeoM.Translate(x, y)
+	op.ColorScale.ScaleWithColor(clr)
+	if style.Transparency > 0 {
+		op.ColorScale.ScaleAlpha(float32(1 - min(style.Transparency, 1)))
+	}
+	op.LineSpacing = lineSpacing(style)
+	switch style.Align {
+	case AlignCenter:
+		op.PrimaryAlign = etext.AlignCenter
+	case AlignRight:
+		op.PrimaryAlign = etext.AlignEnd
+	}
+	op.Filter = ebiten.FilterLinear
+	etext.Draw(dst, str, face, op)
+}
+
+// lineSpacing は複数行の文字列の行の間隔を返す
+func lineSpacing(style Style) float64 {
+	return style.size() * 1.3
+}
+
+// orDefault は色が指定されていなければ既定の色を返す
+func orDefault(clr, def color.Color) color.Color {
+	if clr == nil {
+		return def
+	}
+	return clr
+}
@@ -0,0 +1,142 @@
+package theme
+
+import (
+	"fmt"
+	"image/color"
+
+	"game/internal/entity"
+)
+
+// Theme は画面の配色
+type Theme struct {
+	ID         string       // 設定やメッセージのキーに使う名前
+	Background color.RGBA   // プレイフィールドの背景
+	Panel      color.RGBA   // サイドパネルの背景
+	Border     color.RGBA   // プレイフィールドとサイドパネルの境界線
+	Player     color.RGBA
+	Shield     []color.RGBA // シールドの色（耐久値1, 2, 3…の順、足りない分は最後の色）
+	Bullets    BulletColors // 弾の振る舞いの種類ごとの色
+	Danger     color.RGBA   // 危険度の高い弾の周りの光
+	HUD        HUDColors
+}
+
+// BulletColors は弾の振る舞いの種類ごとの色（中速の弾の色で、遅い弾は暗く、速い弾は明るく描く）
+type BulletColors struct {
+	Plain     color.RGBA
+	Aimed     color.RGBA
+	Homing    color.RGBA
+	Splitting color.RGBA
+}
+
+// HUDColors はHUDの配色
+type HUDColors struct {
+	Text      color.RGBA
+	GaugeBack color.RGBA // ゲージの背景
+	Bomb      color.RGBA // ボムのゲージ
+	Slow      color.RGBA // スローのゲージ
+	Life      color.RGBA // 残機（残機無制限のプレイでのミスの回数）
+	Shield    color.RGBA // シールドの目盛り
+}
+
+// BulletColor は弾の振る舞いの種類と速さの段階に対応する色を返す
+func (t *Theme) BulletColor(kind entity.BulletKind, tier entity.SpeedTier) color.RGBA {
+	var base color.RGBA
+	switch kind {
+	case entity.BulletAimed:
+		base = t.Bullets.Aimed
+	case entity.BulletHoming:
+		base = t.Bullets.Homing
+	case entity.BulletSplitting:
+		base = t.Bullets.Splitting
+	default:
+		base = t.Bullets.Plain
+	}
+	
+	switch tier {
+	case entity.SpeedSlow:
+		return scale(base, 0.7)
+	case entity.SpeedFast:
+		return mix(base, color.RGBA{255, 255, 255, base.A}, 0.4)
+	default:
+		return base
+	}
+}
+
+// DangerColor は弾の危険度に対応する周りの光の色を返す（危険度が低ければ透明）
+func (t *Theme) DangerColor(danger entity.Danger) color.RGBA {
+	switch danger {
+	case entity.DangerHigh:
+		return t.Danger
+	case entity.DangerMedium:
+		c := t.Danger
+		c.A = uint8(float64(c.A) * 0.4)
+		return c
+	default:
+		return color.RGBA{}
+	}
+}
+
+// ShieldColor はシールドの耐久値に対応する色を返す
+func (t *Theme) ShieldColor(durability int) color.RGBA {
+	i := min(max(durability, 1), len(t.Shield)) - 1
+	return t.Shield[i]
+}
+
+// themes は選べる配色の一覧（設定画面での並び順）
+var themes []*Theme
+
+// current は現在の配色
+var current *Theme
+
+// register は配色を一覧に追加する
+func register(t *Theme) {
+	if len(t.Shield) == 0 {
+		panic(fmt.Sprintf("配色 %s にはシールドの色が必要です", t.ID))
+	}
+	themes = append(themes, t)
+}
+
+// Themes は選べる配色の一覧を返す
+func Themes() []*Theme {
+	return themes
+}
+
+// Current は現在の配色を返す
+func Current() *Theme {
+	return current
+}
+
+// Set は名前の配色に切り替える
+func Set(id string) error {
+	for _, t := range themes {
+		if t.ID == id {
+			current = t
+			return nil
+		}
+	}
+	return fmt.Errorf("配色 %q はありません", id)
+}
+
+// Next は現在の配色から一覧で delta 個先の配色に切り替える
+func Next(delta int) {
+	for i, t := range themes {
+		if t == current {
+			n := len(themes)
+			current = themes[((i+delta)%n+n)%n]
+			return
+		}
+	}
+}
+
+// scale は色の明るさを k 倍にする（不透明度はそのまま）
+func scale(c color.RGBA, k float64) color.RGBA {
+	return color.RGBA{uint8(float64(c.R) * k), uint8(float64(c.G) * k), uint8(float64(c.B) * k), c.A}
+}
+
+// mix は色 a と b を t の割合で混ぜる
+func mix(a, b color.RGBA, t float64) color.RGBA {
+	lerp := func(x, y uint8) uint8 {
+		return uint8(float64(x) + (float64(y)-float64(x))*t)
+	}
+	return color.RGBA{lerp(a.R, b.R), lerp(a.G, b.G), lerp(a.B, b.B), lerp(a.A, b.A)}
+}
@@ -0,0 +1,182 @@
+package theme
+
+import "image/color"
+
+// 色覚の多様性に配慮した配色では、色相だけでなく明るさも弾の種類ごとに大きく変えている
+func init() {
+	// 従来の配色
+	register(&Theme{
+		ID:         "classic",
+		Background: color.RGBA{20, 20, 40, 255},
+		Panel:      color.RGBA{10, 10, 20, 255},
+		Border:     color.RGBA{80, 80, 120, 255},
+		Player:     color.RGBA{255, 255, 255, 255},
+		Shield: []color.RGBA{
+			{150, 150, 255, 100}, // 紫がかった色（半透明）
+			{100, 200, 255, 100}, // やや暗い水色（半透明）
+			{0, 255, 255, 100},   // 明るい水色（半透明）
+		},
+		Bullets: BulletColors{
+			Plain:     color.RGBA{80, 200, 255, 255},
+			Aimed:     color.RGBA{255, 170, 60, 255},
+			Homing:    color.RGBA{240, 110, 220, 255},
+			Splitting: color.RGBA{120, 230, 100, 255},
+		},
+		Danger:     color.RGBA{255, 60, 60, 170},
+		HUD: HUDColors{
+			Text:      color.RGBA{255, 255, 255, 255},
+			GaugeBack: color.RGBA{50, 50, 50, 200},
+			Bomb:      color.RGBA{0, 255, 255, 200},
+			Slow:      color.RGBA{170, 110, 255, 200},
+			Life:      color.RGBA{255, 80, 120, 255},
+			Shield:    color.RGBA{0, 255, 255, 255},
+		},
+	})
+	
+	// 高コントラスト: 真っ黒な背景に明るく彩度の高い色
+	register(&Theme{
+		ID:         "high_contrast",
+		Background: color.RGBA{0, 0, 0, 255},
+		Panel:      color.RGBA{0, 0, 0, 255},
+		Border:     color.RGBA{255, 255, 255, 255},
+		Player:     color.RGBA{255, 255, 255, 255},
+		Shield: []color.RGBA{
+			{255, 255, 255, 120},
+			{0, 255, 255, 140},
+			{0, 255, 0, 160},
+		},
+		Bullets: BulletColors{
+			Plain:     color.RGBA{0, 255, 255, 255},
+			Aimed:     color.RGBA{255, 255, 0, 255},
+			Homing:    color.RGBA{255, 0, 255, 255},
+			Splitting: color.RGBA{255, 128, 0, 255},
+		},
+		Danger:     color.RGBA{255, 0, 0, 200},
+		HUD: HUDColors{
+			Text:      color.RGBA{255, 255, 255, 255},
+			GaugeBack: color.RGBA{90, 90, 90, 255},
+			Bomb:      color.RGBA{0, 255, 255, 255},
+			Slow:      color.RGBA{255, 0, 255, 255},
+			Life:      color.RGBA{255, 255, 0, 255},
+			Shield:    color.RGBA{0, 255, 0, 255},
+		},
+	})
+	
+	// 2型2色覚（緑が見えにくい）: 赤と緑を避け、青と朱色・黄の対比を使う（Okabe-Itoの配色）
+	register(&Theme{
+		ID:         "deuteranopia",
+		Background: color.RGBA{20, 20, 40, 255},
+		Panel:      color.RGBA{10, 10, 20, 255},
+		Border:     color.RGBA{86, 180, 233, 255},
+		Player:     color.RGBA{255, 255, 255, 255},
+		Shield: []color.RGBA{
+			{213, 94, 0, 110},
+			{86, 180, 233, 110},
+			{240, 228, 66, 110},
+		},
+		Bullets: BulletColors{
+			Plain:     color.RGBA{86, 180, 233, 255},
+			Aimed:     color.RGBA{240, 228, 66, 255},
+			Homing:    color.RGBA{213, 94, 0, 255},
+			Splitting: color.RGBA{204, 121, 167, 255},
+		},
+		Danger:     color.RGBA{255, 255, 255, 150},
+		HUD: HUDColors{
+			Text:      color.RGBA{255, 255, 255, 255},
+			GaugeBack: color.RGBA{50, 50, 50, 200},
+			Bomb:      color.RGBA{86, 180, 233, 220},
+			Slow:      color.RGBA{240, 228, 66, 220},
+			Life:      color.RGBA{213, 94, 0, 255},
+			Shield:    color.RGBA{86, 180, 233, 255},
+		},
+	})
+	
+	// 1型2色覚（赤が見えにくい）: 2型と同様に青と橙・黄を中心にし、暗く見える赤系は使わない
+	register(&Theme{
+		ID:         "protanopia",
+		Background: color.RGBA{20, 20, 40, 255},
+		Panel:      color.RGBA{10, 10, 20, 255},
+		Border:     color.RGBA{86, 180, 233, 255},
+		Player:     color.RGBA{255, 255, 255, 255},
+		Shield: []color.RGBA{
+			{204, 121, 167, 110},
+			{86, 180, 233, 110},
+			{240, 228, 66, 110},
+		},
+		Bullets: BulletColors{
+			Plain:     color.RGBA{86, 180, 233, 255},
+			Aimed:     color.RGBA{240, 228, 66, 255},
+			Homing:    color.RGBA{230, 159, 0, 255},
+			Splitting: color.RGBA{204, 121, 167, 255},
+		},
+		Danger:     color.RGBA{255, 255, 255, 150},
+		HUD: HUDColors{
+			Text:      color.RGBA{255, 255, 255, 255},
+			GaugeBack: color.RGBA{50, 50, 50, 200},
+			Bomb:      color.RGBA{86, 180, 233, 220},
+			Slow:      color.RGBA{240, 228, 66, 220},
+			Life:      color.RGBA{230, 159, 0, 255},
+			Shield:    color.RGBA{86, 180, 233, 255},
+		},
+	})
+	
+	// 3型2色覚（青と黄が見分けにくい）: 赤・ピンクと青緑の対比を使う
+	register(&Theme{
+		ID:         "tritanopia",
+		Background: color.RGBA{24, 24, 28, 255},
+		Panel:      color.RGBA{12, 12, 14, 255},
+		Border:     color.RGBA{0, 170, 160, 255},
+		Player:     color.RGBA{255, 255, 255, 255},
+		Shield: []color.RGBA{
+			{255, 143, 177, 110},
+			{0, 170, 160, 110},
+			{255, 255, 255, 110},
+		},
+		Bullets: BulletColors{
+			Plain:     color.RGBA{0, 190, 175, 255},
+			Aimed:     color.RGBA{255, 143, 177, 255},
+			Homing:    color.RGBA{230, 40, 60, 255},
+			Splitting: color.RGBA{255, 255, 255, 255},
+		},
+		Danger:     color.RGBA{255, 255, 255, 150},
+		HUD: HUDColors{
+			Text:      color.RGBA{255, 255, 255, 255},
+			GaugeBack: color.RGBA{55, 55, 55, 200},
+			Bomb:      color.RGBA{0, 190, 175, 220},
+			Slow:      color.RGBA{255, 143, 177, 220},
+			Life:      color.RGBA{230, 40, 60, 255},
+			Shield:    color.RGBA{0, 190, 175, 255},
+		},
+	})
+	
+	// モノクロ: 明るさの違いだけで見分ける
+	register(&Theme{
+		ID:         "monochrome",
+		Background: color.RGBA{16, 16, 16, 255},
+		Panel:      color.RGBA{8, 8, 8, 255},
+		Border:     color.RGBA{128, 128, 128, 255},
+		Player:     color.RGBA{255, 255, 255, 255},
+		Shield: []color.RGBA{
+			{120, 120, 120, 110},
+			{180, 180, 180, 110},
+			{240, 240, 240, 110},
+		},
+		Bullets: BulletColors{
+			Plain:     color.RGBA{150, 150, 150, 255},
+			Aimed:     color.RGBA{200, 200, 200, 255},
+			Homing:    color.RGBA{255, 255, 255, 255},
+			Splitting: color.RGBA{110, 110, 110, 255},
+		},
+		Danger:     color.RGBA{255, 255, 255, 130},
+		HUD: HUDColors{
+			Text:      color.RGBA{255, 255, 255, 255},
+			GaugeBack: color.RGBA{60, 60, 60, 200},
+			Bomb:      color.RGBA{230, 230, 230, 220},
+			Slow:      color.RGBA{160, 160, 160, 220},
+			Life:      color.RGBA{255, 255, 255, 255},
+			Shield:    color.RGBA{200, 200, 200, 255},
+		},
+	})
+	
+	current = themes[0]
+}